@@ -1,6 +1,12 @@
 package main
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
 	"github.com/skip2/go-qrcode"
 	"go.mau.fi/mautrix-signal/pkg/signalmeow"
 	"maunium.net/go/mautrix/bridge/commands"
@@ -22,6 +28,11 @@ func (br *SignalBridge) RegisterCommands() {
 	proc.AddHandlers(
 		cmdPing,
 		cmdLogin,
+		cmdDisappearingTimer,
+		cmdCreate,
+		cmdJoin,
+		cmdInviteLink,
+		cmdResetInviteLink,
 	)
 }
 
@@ -137,6 +148,272 @@ func fnLogin(ce *WrappedCommandEvent) {
 	ce.User.Connect()
 }
 
+var cmdDisappearingTimer = &commands.FullHandler{
+	Func: wrapCommand(fnDisappearingTimer),
+	Name: "disappearing-timer",
+	Help: commands.HelpMeta{
+		Section:     HelpSectionPortalManagement,
+		Description: "Set the disappearing message timer of the current chat.",
+		Args:        "<_duration_|off>",
+	},
+	RequiresPortal: true,
+	RequiresLogin:  true,
+}
+
+func fnDisappearingTimer(ce *WrappedCommandEvent) {
+	if len(ce.Args) != 1 {
+		ce.Reply("**Usage:** `disappearing-timer <duration|off>` (e.g. `30s`, `8h`, `1w`)")
+		return
+	}
+	expireTimer, err := parseDisappearingTimer(ce.Args[0])
+	if err != nil {
+		ce.Reply("Invalid duration: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if ce.Portal.IsPrivateChat() {
+		msg := signalmeow.DataMessageForExpireTimerUpdate(expireTimer)
+		result := signalmeow.SendMessage(ctx, ce.User.SignalDevice, ce.Portal.ChatID, msg)
+		if !result.WasSuccessful {
+			err = result.FailedSendResult.Error
+		}
+	} else {
+		err = signalmeow.SetGroupDisappearingMessagesTimer(ctx, ce.User.SignalDevice, signalmeow.GroupIdentifier(ce.Portal.ChatID), expireTimer)
+	}
+	if err != nil {
+		ce.Log.Errorfln("Failed to set disappearing timer in %s: %v", ce.Portal.ChatID, err)
+		ce.Reply("Failed to set disappearing timer: %v", err)
+		return
+	}
+
+	ce.Portal.ExpirationTime = int(expireTimer)
+	err = ce.Portal.Update()
+	if err != nil {
+		ce.Log.Errorfln("Failed to save expiration time of %s: %v", ce.Portal.ChatID, err)
+	}
+	if expireTimer == 0 {
+		ce.Reply("Disappearing messages disabled")
+	} else {
+		ce.Reply("Disappearing messages set to %s", formatDisappearingTimer(expireTimer))
+	}
+}
+
+var cmdCreate = &commands.FullHandler{
+	Func: wrapCommand(fnCreate),
+	Name: "create",
+	Help: commands.HelpMeta{
+		Section:     HelpSectionPortalManagement,
+		Description: "Create a Signal group for the current Matrix room. The Signal users in the room are added to the group.",
+	},
+	RequiresLogin: true,
+}
+
+func fnCreate(ce *WrappedCommandEvent) {
+	if ce.Portal != nil {
+		ce.Reply("This is already a portal room")
+		return
+	}
+
+	roomState, err := ce.Bot.State(ce.RoomID)
+	if err != nil {
+		ce.Reply("Failed to get room state: %v", err)
+		return
+	}
+	levelsEvt, ok := roomState[event.StatePowerLevels][""]
+	if !ok {
+		ce.Reply("Failed to get room power levels")
+		return
+	}
+	levels := levelsEvt.Content.AsPowerLevels()
+	if levels.GetUserLevel(ce.Bot.UserID) < levels.GetEventLevel(event.StatePowerLevels) {
+		ce.Reply("Please give the bridge bot permission to change power levels before creating a Signal group")
+		return
+	}
+	var name, topic string
+	var avatarURL id.ContentURI
+	if nameEvt, ok := roomState[event.StateRoomName][""]; ok {
+		name = nameEvt.Content.AsRoomName().Name
+	}
+	if topicEvt, ok := roomState[event.StateTopic][""]; ok {
+		topic = topicEvt.Content.AsTopic().Topic
+	}
+	if avatarEvt, ok := roomState[event.StateRoomAvatar][""]; ok {
+		avatarURL = avatarEvt.Content.AsRoomAvatar().URL
+	}
+	_, encrypted := roomState[event.StateEncryption][""]
+	if name == "" {
+		ce.Reply("Please set a name for the room first")
+		return
+	}
+
+	var members []string
+	for userID, memberEvt := range roomState[event.StateMember] {
+		membership := memberEvt.Content.AsMember().Membership
+		if membership != event.MembershipJoin && membership != event.MembershipInvite {
+			continue
+		}
+		if signalID, isPuppet := ce.Bridge.ParsePuppetMXID(id.UserID(userID)); isPuppet {
+			members = append(members, signalID)
+		} else if user := ce.Bridge.GetUserByMXID(id.UserID(userID)); user != nil && user != ce.User && user.SignalID != "" {
+			members = append(members, user.SignalID)
+		}
+	}
+
+	var avatar []byte
+	if !avatarURL.IsEmpty() {
+		avatar, err = ce.Bot.DownloadBytes(avatarURL)
+		if err != nil {
+			ce.Reply("Failed to download room avatar: %v", err)
+			return
+		}
+	}
+
+	ce.Log.Infofln("Creating Signal group for %s with %d other members", ce.RoomID, len(members))
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	gid, err := signalmeow.CreateGroup(ctx, ce.User.SignalDevice, name, topic, avatar, 0, members)
+	if err != nil {
+		ce.Log.Errorfln("Failed to create Signal group for %s: %v", ce.RoomID, err)
+		ce.Reply("Failed to create Signal group: %v", err)
+		return
+	}
+
+	portal := ce.User.GetPortalByChatID(string(gid))
+	portal.roomCreateLock.Lock()
+	defer portal.roomCreateLock.Unlock()
+	portal.MXID = ce.RoomID
+	portal.Name = name
+	portal.NameSet = true
+	portal.Topic = topic
+	portal.AvatarURL = avatarURL
+	portal.AvatarSet = !avatarURL.IsEmpty()
+	if len(avatar) > 0 {
+		hash := sha256.Sum256(avatar)
+		portal.AvatarHash = hex.EncodeToString(hash[:])
+	}
+	portal.Encrypted = encrypted
+	ce.Bridge.portalsLock.Lock()
+	ce.Bridge.portalsByMXID[portal.MXID] = portal
+	ce.Bridge.portalsLock.Unlock()
+	portal.Update()
+	portal.UpdateBridgeInfo()
+
+	group, err := signalmeow.RetrieveGroupByID(ctx, ce.User.SignalDevice, gid)
+	if err != nil {
+		ce.Log.Warnfln("Failed to fetch new Signal group %s: %v", gid, err)
+	} else {
+		portal.syncGroupMembership(ce.User, group)
+	}
+	ce.Reply("Successfully created Signal group")
+}
+
+var cmdJoin = &commands.FullHandler{
+	Func: wrapCommand(fnJoin),
+	Name: "join",
+	Help: commands.HelpMeta{
+		Section:     HelpSectionPortalManagement,
+		Description: "Join a Signal group with an invite link.",
+		Args:        "<_link_>",
+	},
+	RequiresLogin: true,
+}
+
+func fnJoin(ce *WrappedCommandEvent) {
+	if len(ce.Args) != 1 {
+		ce.Reply("**Usage:** `join <link>`")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	joinInfo, err := signalmeow.FetchGroupJoinInfo(ctx, ce.User.SignalDevice, ce.Args[0])
+	if err != nil {
+		ce.Reply("Failed to get group info: %v", err)
+		return
+	}
+	requested, err := signalmeow.JoinGroupWithInviteLink(ctx, ce.User.SignalDevice, joinInfo)
+	if err != nil {
+		ce.Log.Errorfln("Failed to join Signal group %s: %v", joinInfo.GroupIdentifier, err)
+		ce.Reply("Failed to join %s: %v", joinInfo.Title, err)
+		return
+	}
+	if requested {
+		ce.Reply("Asked to join %s, an admin of the group has to approve the request", joinInfo.Title)
+		return
+	}
+
+	portal := ce.User.GetPortalByChatID(string(joinInfo.GroupIdentifier))
+	if portal.MXID == "" {
+		portal.Name = joinInfo.Title
+		portal.Topic = joinInfo.Description
+		err = portal.CreateMatrixRoom(ce.User, nil)
+		if err != nil {
+			ce.Reply("Joined %s, but failed to create a portal room: %v", joinInfo.Title, err)
+			return
+		}
+		_ = ensureGroupPuppetsAreJoinedToPortal(ctx, ce.User, portal)
+	} else {
+		portal.ensureUserInvited(ce.User)
+	}
+	ce.Reply("Joined %s", joinInfo.Title)
+}
+
+var cmdInviteLink = &commands.FullHandler{
+	Func: wrapCommand(fnInviteLink),
+	Name: "invite-link",
+	Help: commands.HelpMeta{
+		Section:     HelpSectionPortalManagement,
+		Description: "Get the invite link of the current Signal group.",
+	},
+	RequiresPortal: true,
+	RequiresLogin:  true,
+}
+
+func fnInviteLink(ce *WrappedCommandEvent) {
+	if ce.Portal.IsPrivateChat() {
+		ce.Reply("Private chats don't have invite links")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	link, err := signalmeow.GetGroupInviteLink(ctx, ce.User.SignalDevice, signalmeow.GroupIdentifier(ce.Portal.ChatID))
+	if errors.Is(err, signalmeow.ErrInviteLinkDisabled) {
+		ce.Reply("The invite link of this group is disabled. Use `reset-invite-link` to enable it.")
+	} else if err != nil {
+		ce.Reply("Failed to get invite link: %v", err)
+	} else {
+		ce.Reply(link)
+	}
+}
+
+var cmdResetInviteLink = &commands.FullHandler{
+	Func: wrapCommand(fnResetInviteLink),
+	Name: "reset-invite-link",
+	Help: commands.HelpMeta{
+		Section:     HelpSectionPortalManagement,
+		Description: "Replace the invite link of the current Signal group, so the old link stops working.",
+	},
+	RequiresPortal: true,
+	RequiresLogin:  true,
+}
+
+func fnResetInviteLink(ce *WrappedCommandEvent) {
+	if ce.Portal.IsPrivateChat() {
+		ce.Reply("Private chats don't have invite links")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	link, err := signalmeow.ResetGroupInviteLink(ctx, ce.User.SignalDevice, signalmeow.GroupIdentifier(ce.Portal.ChatID))
+	if err != nil {
+		ce.Log.Errorfln("Failed to reset invite link of %s: %v", ce.Portal.ChatID, err)
+		ce.Reply("Failed to reset invite link: %v", err)
+		return
+	}
+	ce.Reply("Invite link reset. The new link is %s", link)
+}
+
 func (user *User) sendQR(ce *WrappedCommandEvent, code string, prevEvent id.EventID) id.EventID {
 	url, ok := user.uploadQR(ce, code)
 	if !ok {
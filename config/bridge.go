@@ -19,6 +19,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"text/template"
 	"time"
@@ -34,16 +35,26 @@ type BridgeConfig struct {
 
 	PortalMessageBuffer int `yaml:"portal_message_buffer"`
 
+	MaxAttachmentSizeMB int `yaml:"max_attachment_size_mb"`
+
 	DoublePuppetConfig bridgeconfig.DoublePuppetConfig `yaml:",inline"`
 
 	BridgeNotices       bool `yaml:"bridge_notices"`
 	DeliveryReceipts    bool `yaml:"delivery_receipts"`
 	MessageStatusEvents bool `yaml:"message_status_events"`
 	MessageErrorNotices bool `yaml:"message_error_notices"`
+	URLPreviews         bool `yaml:"url_previews"`
 	SyncDirectChatList  bool `yaml:"sync_direct_chat_list"`
 	ResendBridgeInfo    bool `yaml:"resend_bridge_info"`
 	FederateRooms       bool `yaml:"federate_rooms"`
 
+	LocationPreviewURL string `yaml:"location_preview_url"`
+
+	ViewOnce struct {
+		Mode    string `yaml:"mode"`
+		Timeout int    `yaml:"timeout"`
+	} `yaml:"view_once"`
+
 	MessageHandlingTimeout struct {
 		ErrorAfterStr string `yaml:"error_after"`
 		DeadlineStr   string `yaml:"deadline"`
@@ -64,8 +75,9 @@ type BridgeConfig struct {
 
 	Permissions bridgeconfig.PermissionConfig `yaml:"permissions"`
 
-	usernameTemplate    *template.Template `yaml:"-"`
-	displaynameTemplate *template.Template `yaml:"-"`
+	usernameTemplate        *template.Template `yaml:"-"`
+	displaynameTemplate     *template.Template `yaml:"-"`
+	locationPreviewTemplate *template.Template `yaml:"-"`
 }
 
 func (bc *BridgeConfig) GetResendBridgeInfo() bool {
@@ -80,6 +92,22 @@ func (bc *BridgeConfig) EnableMessageErrorNotices() bool {
 	return bc.MessageErrorNotices
 }
 
+// MaxAttachmentSize returns the size limit of attachments bridged in either direction in bytes
+func (bc *BridgeConfig) MaxAttachmentSize() int64 {
+	if bc.MaxAttachmentSizeMB <= 0 {
+		return 100 * 1024 * 1024
+	}
+	return int64(bc.MaxAttachmentSizeMB) * 1024 * 1024
+}
+
+// ViewOnceTimeout returns how long view-once media is kept in the room if it isn't viewed, in seconds
+func (bc *BridgeConfig) ViewOnceTimeout() uint32 {
+	if bc.ViewOnce.Timeout <= 0 {
+		return 24 * 60 * 60
+	}
+	return uint32(bc.ViewOnce.Timeout)
+}
+
 func boolToInt(val bool) int {
 	if val {
 		return 1
@@ -95,6 +123,11 @@ func (bc *BridgeConfig) Validate() error {
 	if len(bc.Permissions) <= exampleLen {
 		return errors.New("bridge.permissions not configured")
 	}
+	switch bc.ViewOnce.Mode {
+	case "notice", "redact", "normal":
+	default:
+		return fmt.Errorf("invalid bridge.view_once.mode %q", bc.ViewOnce.Mode)
+	}
 	return nil
 }
 
@@ -116,6 +149,12 @@ func (bc *BridgeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if err != nil {
 		return err
 	}
+	if bc.LocationPreviewURL != "" {
+		bc.locationPreviewTemplate, err = template.New("location_preview").Parse(bc.LocationPreviewURL)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -143,3 +182,22 @@ func (bc BridgeConfig) FormatUsername(userID string) string {
 	_ = bc.usernameTemplate.Execute(&buffer, userID)
 	return buffer.String()
 }
+
+type locationTemplateArgs struct {
+	Lat  string
+	Long string
+}
+
+// FormatLocationPreviewURL returns the URL of a static map image for the given coordinates,
+// or an empty string if location previews aren't configured.
+func (bc BridgeConfig) FormatLocationPreviewURL(lat, long string) string {
+	if bc.locationPreviewTemplate == nil {
+		return ""
+	}
+	var buffer strings.Builder
+	_ = bc.locationPreviewTemplate.Execute(&buffer, locationTemplateArgs{
+		Lat:  url.QueryEscape(lat),
+		Long: url.QueryEscape(long),
+	})
+	return buffer.String()
+}
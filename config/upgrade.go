@@ -28,10 +28,16 @@ func DoUpgrade(helper *up.Helper) {
 	helper.Copy(up.Str, "bridge", "username_template")
 	helper.Copy(up.Str, "bridge", "displayname_template")
 	helper.Copy(up.Str, "bridge", "private_chat_portal_meta")
+	helper.Copy(up.Int, "bridge", "startup_private_channel_create_limit")
 	helper.Copy(up.Int, "bridge", "portal_message_buffer")
+	helper.Copy(up.Int, "bridge", "max_attachment_size_mb")
 	helper.Copy(up.Bool, "bridge", "delivery_receipts")
 	helper.Copy(up.Bool, "bridge", "message_status_events")
 	helper.Copy(up.Bool, "bridge", "message_error_notices")
+	helper.Copy(up.Bool, "bridge", "url_previews")
+	helper.Copy(up.Str, "bridge", "location_preview_url")
+	helper.Copy(up.Str, "bridge", "view_once", "mode")
+	helper.Copy(up.Int, "bridge", "view_once", "timeout")
 	helper.Copy(up.Bool, "bridge", "sync_direct_chat_list")
 	helper.Copy(up.Bool, "bridge", "resend_bridge_info")
 	helper.Copy(up.Bool, "bridge", "federate_rooms")
@@ -14,11 +14,16 @@ import (
 type Database struct {
 	*dbutil.Database
 
-	User     *UserQuery
-	Portal   *PortalQuery
-	Puppet   *PuppetQuery
-	Message  *MessageQuery
-	Reaction *ReactionQuery
+	User           *UserQuery
+	Portal         *PortalQuery
+	Puppet         *PuppetQuery
+	Message        *MessageQuery
+	MessageReceipt *MessageReceiptQuery
+	MessageEdit    *MessageEditQuery
+	Reaction       *ReactionQuery
+
+	DisappearingMessage *DisappearingMessageQuery
+	ViewOnceMessage     *ViewOnceMessageQuery
 }
 
 func New(baseDB *dbutil.Database, log maulogger.Logger) *Database {
@@ -40,10 +45,26 @@ func New(baseDB *dbutil.Database, log maulogger.Logger) *Database {
 		db:  db,
 		log: log.Sub("Message"),
 	}
+	db.MessageReceipt = &MessageReceiptQuery{
+		db:  db,
+		log: log.Sub("MessageReceipt"),
+	}
+	db.MessageEdit = &MessageEditQuery{
+		db:  db,
+		log: log.Sub("MessageEdit"),
+	}
 	db.Reaction = &ReactionQuery{
 		db:  db,
 		log: log.Sub("Reaction"),
 	}
+	db.DisappearingMessage = &DisappearingMessageQuery{
+		db:  db,
+		log: log.Sub("DisappearingMessage"),
+	}
+	db.ViewOnceMessage = &ViewOnceMessageQuery{
+		db:  db,
+		log: log.Sub("ViewOnceMessage"),
+	}
 	return db
 }
 
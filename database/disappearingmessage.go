@@ -0,0 +1,147 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.mau.fi/util/dbutil"
+	log "maunium.net/go/maulogger/v2"
+	"maunium.net/go/mautrix/id"
+)
+
+type DisappearingMessageQuery struct {
+	db  *Database
+	log log.Logger
+}
+
+func (dmq *DisappearingMessageQuery) New() *DisappearingMessage {
+	return &DisappearingMessage{
+		db:  dmq.db,
+		log: dmq.log,
+	}
+}
+
+func (dmq *DisappearingMessageQuery) NewWithValues(roomID id.RoomID, eventID id.EventID, expireIn time.Duration, expireAt time.Time) *DisappearingMessage {
+	dm := dmq.New()
+	dm.RoomID = roomID
+	dm.EventID = eventID
+	dm.ExpireIn = expireIn
+	dm.ExpireAt = expireAt
+	return dm
+}
+
+// DisappearingMessage is a Matrix event that will be redacted once its Signal message expires.
+// ExpireAt is zero until the timer has been started (i.e. the message has been read).
+type DisappearingMessage struct {
+	db  *Database
+	log log.Logger
+
+	RoomID   id.RoomID
+	EventID  id.EventID
+	ExpireIn time.Duration
+	ExpireAt time.Time
+}
+
+const (
+	getUnstartedDisappearingMessagesUpToQuery = `
+		SELECT disappearing_message.room_id, disappearing_message.mxid, expiration_seconds, expiration_ts
+		FROM disappearing_message
+		INNER JOIN message ON message.mxid=disappearing_message.mxid AND message.mx_room=disappearing_message.room_id
+		WHERE disappearing_message.room_id=$1 AND expiration_ts IS NULL AND message.timestamp <= $2
+	`
+	getUpcomingDisappearingMessagesQuery = `
+		SELECT room_id, mxid, expiration_seconds, expiration_ts FROM disappearing_message
+		WHERE expiration_ts IS NOT NULL AND expiration_ts <= $1
+	`
+	getDisappearingMessageByMXIDQuery = `
+		SELECT room_id, mxid, expiration_seconds, expiration_ts FROM disappearing_message
+		WHERE room_id=$1 AND mxid=$2
+	`
+)
+
+func (dm *DisappearingMessage) Insert(txn dbutil.Execable) {
+	if txn == nil {
+		txn = dm.db
+	}
+	var expireAt sql.NullInt64
+	if !dm.ExpireAt.IsZero() {
+		expireAt = sql.NullInt64{Int64: dm.ExpireAt.UnixMilli(), Valid: true}
+	}
+	_, err := txn.Exec(`
+		INSERT INTO disappearing_message (room_id, mxid, expiration_seconds, expiration_ts)
+		VALUES ($1, $2, $3, $4)
+	`, dm.RoomID, dm.EventID, int64(dm.ExpireIn.Seconds()), expireAt)
+	if err != nil {
+		dm.log.Warnfln("Failed to insert disappearing message %s/%s: %v", dm.RoomID, dm.EventID, err)
+	}
+}
+
+// StartTimer sets the expiry time of the message to ExpireIn from now, unless it was already started.
+func (dm *DisappearingMessage) StartTimer() {
+	if !dm.ExpireAt.IsZero() {
+		return
+	}
+	dm.ExpireAt = time.Now().Add(dm.ExpireIn)
+	_, err := dm.db.Exec(`
+		UPDATE disappearing_message SET expiration_ts=$1
+		WHERE room_id=$2 AND mxid=$3 AND expiration_ts IS NULL
+	`, dm.ExpireAt.UnixMilli(), dm.RoomID, dm.EventID)
+	if err != nil {
+		dm.log.Warnfln("Failed to start timer of disappearing message %s/%s: %v", dm.RoomID, dm.EventID, err)
+	}
+}
+
+func (dm *DisappearingMessage) Delete() {
+	_, err := dm.db.Exec("DELETE FROM disappearing_message WHERE room_id=$1 AND mxid=$2", dm.RoomID, dm.EventID)
+	if err != nil {
+		dm.log.Warnfln("Failed to delete disappearing message %s/%s: %v", dm.RoomID, dm.EventID, err)
+	}
+}
+
+func (dm *DisappearingMessage) Scan(row dbutil.Scannable) *DisappearingMessage {
+	var expireIn int64
+	var expireAt sql.NullInt64
+	err := row.Scan(&dm.RoomID, &dm.EventID, &expireIn, &expireAt)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			dm.log.Errorln("Database scan failed:", err)
+		}
+		return nil
+	}
+	dm.ExpireIn = time.Duration(expireIn) * time.Second
+	if expireAt.Valid {
+		dm.ExpireAt = time.UnixMilli(expireAt.Int64)
+	}
+	return dm
+}
+
+func (dmq *DisappearingMessageQuery) getAll(query string, args ...interface{}) (messages []*DisappearingMessage) {
+	rows, err := dmq.db.Query(query, args...)
+	if err != nil || rows == nil {
+		dmq.log.Warnfln("Failed to query disappearing messages: %v", err)
+		return nil
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if dm := dmq.New().Scan(rows); dm != nil {
+			messages = append(messages, dm)
+		}
+	}
+	return
+}
+
+func (dmq *DisappearingMessageQuery) GetByMXID(roomID id.RoomID, eventID id.EventID) *DisappearingMessage {
+	return dmq.New().Scan(dmq.db.QueryRow(getDisappearingMessageByMXIDQuery, roomID, eventID))
+}
+
+// GetUnstartedUpTo returns the disappearing messages in the room whose timer hasn't been started yet,
+// and that were sent at or before the given Signal timestamp, i.e. the ones a read receipt for it covers.
+func (dmq *DisappearingMessageQuery) GetUnstartedUpTo(roomID id.RoomID, timestamp uint64) []*DisappearingMessage {
+	return dmq.getAll(getUnstartedDisappearingMessagesUpToQuery, roomID, int64(timestamp))
+}
+
+// GetUpcomingScheduled returns the started disappearing messages that expire within the given duration.
+func (dmq *DisappearingMessageQuery) GetUpcomingScheduled(duration time.Duration) []*DisappearingMessage {
+	return dmq.getAll(getUpcomingDisappearingMessagesQuery, time.Now().Add(duration).UnixMilli())
+}
@@ -29,29 +29,33 @@ type Message struct {
 	MXRoom         id.RoomID
 	Sender         string
 	Timestamp      uint64
+	PartIndex      int // Index of the Matrix event when one Signal message is bridged as several (e.g. an album)
 	SignalChatID   string
 	SignalReceiver string
 }
 
 const (
 	getAllMessagesQuery = `
-		SELECT mxid, mx_room, sender, timestamp, signal_chat_id, signal_receiver FROM message
+		SELECT mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver FROM message
 		WHERE signal_chat_id=$1 AND signal_receiver=$2
 	`
 	getMessageByMXIDQuery = `
-		SELECT mxid, mx_room, sender, timestamp, signal_chat_id, signal_receiver FROM message
+		SELECT mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver FROM message
 		WHERE mxid=$1
 	`
 	getMessagesBySignalIDQuery = `
-        SELECT mxid, mx_room, sender, timestamp, signal_chat_id, signal_receiver FROM message
+        SELECT mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver FROM message
         WHERE sender=$1 AND timestamp=$2 AND signal_chat_id=$3 AND signal_receiver=$4
+        ORDER BY part_index
 	`
 	findBySenderAndTimestampQuery = `
-		SELECT mxid, mx_room, sender, timestamp, signal_chat_id, signal_receiver FROM message
+		SELECT mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver FROM message
 		WHERE sender=$1 AND timestamp=$2
+		ORDER BY part_index
+		LIMIT 1
 	`
 	getFirstBeforeQuery = `
-		SELECT mxid, mx_room, sender, timestamp, signal_chat_id, signal_receiver FROM message
+		SELECT mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver FROM message
 		WHERE mx_room=$1 AND timestamp <= $2
 		ORDER BY timestamp DESC
 		LIMIT 1
@@ -63,16 +67,17 @@ func (msg *Message) Insert(txn dbutil.Execable) {
 		txn = msg.db
 	}
 	_, err := txn.Exec(`
-		INSERT INTO message (mxid, mx_room, sender, timestamp, signal_chat_id, signal_receiver)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO message (mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`,
-		msg.MXID.String(), msg.MXRoom, msg.Sender, msg.Timestamp, msg.SignalChatID, msg.SignalReceiver)
-	msg.log.Debugfln("Inserting message", msg.MXID, msg.MXRoom, msg.Sender, msg.Timestamp, msg.SignalChatID, msg.SignalReceiver)
+		msg.MXID.String(), msg.MXRoom, msg.Sender, msg.Timestamp, msg.PartIndex, msg.SignalChatID, msg.SignalReceiver)
+	msg.log.Debugfln("Inserting message", msg.MXID, msg.MXRoom, msg.Sender, msg.Timestamp, msg.PartIndex, msg.SignalChatID, msg.SignalReceiver)
 	if err != nil {
 		msg.log.Warnfln("Failed to insert %s, %s: %v", msg.SignalChatID, msg.MXID, err)
 	}
 }
 
+// Delete removes every part of the Signal message, not just this one
 func (msg *Message) Delete(txn dbutil.Execable) {
 	if txn == nil {
 		txn = msg.db
@@ -95,6 +100,7 @@ func (msg *Message) Scan(row dbutil.Scannable) *Message {
 		&msg.MXRoom,
 		&msg.Sender,
 		&timestamp,
+		&msg.PartIndex,
 		&signalChatID,
 		&signalReceiver,
 	)
@@ -141,10 +147,27 @@ func (mq *MessageQuery) GetByMXID(mxid id.EventID) *Message {
 	return mq.maybeScan(mq.db.QueryRow(getMessageByMXIDQuery, mxid))
 }
 
+// GetBySignalID returns the first part of a Signal message
 func (mq *MessageQuery) GetBySignalID(sender string, timestamp uint64, chatID string, receiver string) *Message {
 	return mq.maybeScan(mq.db.QueryRow(getMessagesBySignalIDQuery, sender, timestamp, chatID, receiver))
 }
 
+// GetAllPartsBySignalID returns all the Matrix events a Signal message was bridged as, ordered by part index
+func (mq *MessageQuery) GetAllPartsBySignalID(sender string, timestamp uint64, chatID string, receiver string) (messages []*Message) {
+	rows, err := mq.db.Query(getMessagesBySignalIDQuery, sender, timestamp, chatID, receiver)
+	if err != nil || rows == nil {
+		mq.log.Warnfln("Failed to query parts of message %s/%d: %v", sender, timestamp, err)
+		return nil
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if msg := mq.New().Scan(rows); msg != nil {
+			messages = append(messages, msg)
+		}
+	}
+	return
+}
+
 func (mq *MessageQuery) FindByTimestamps(timestamps []uint64) []*Message {
 	var messages []*Message
 	var rows dbutil.Rows
@@ -152,7 +175,7 @@ func (mq *MessageQuery) FindByTimestamps(timestamps []uint64) []*Message {
 
 	if mq.db.Dialect == dbutil.Postgres {
 		rows, err = mq.db.Query(`
-			SELECT mxid, mx_room, sender, timestamp, signal_chat_id, signal_receiver FROM message
+			SELECT mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver FROM message
 			WHERE timestamp=ANY($1)
 			`, timestamps)
 	} else {
@@ -161,7 +184,7 @@ func (mq *MessageQuery) FindByTimestamps(timestamps []uint64) []*Message {
 			placeholders += "?"
 		}
 		rows, err = mq.db.Query(`
-			SELECT mxid, mx_room, sender, timestamp, signal_chat_id, signal_receiver FROM message
+			SELECT mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver FROM message
 			WHERE timestamp IN ($1)
 			`, timestamps)
 	}
@@ -174,6 +197,7 @@ func (mq *MessageQuery) FindByTimestamps(timestamps []uint64) []*Message {
 	return messages
 }
 
+// FindBySenderAndTimestamp returns the first part of a Signal message in any chat
 func (mq *MessageQuery) FindBySenderAndTimestamp(sender string, timestamp uint64) *Message {
 	return mq.New().Scan(mq.db.QueryRow(findBySenderAndTimestampQuery, sender, timestamp))
 }
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+
+	log "maunium.net/go/maulogger/v2"
+)
+
+type MessageEditQuery struct {
+	db  *Database
+	log log.Logger
+}
+
+const (
+	insertMessageEditQuery = `
+		INSERT INTO message_edit (signal_chat_id, signal_receiver, msg_sender, msg_timestamp, edit_timestamp)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (signal_chat_id, signal_receiver, msg_sender, edit_timestamp) DO NOTHING
+	`
+	getEditedMessageTimestampQuery = `
+		SELECT msg_timestamp FROM message_edit
+		WHERE signal_chat_id=$1 AND signal_receiver=$2 AND msg_sender=$3 AND edit_timestamp=$4
+	`
+	getLatestEditTimestampQuery = `
+		SELECT COALESCE(MAX(edit_timestamp), $4) FROM message_edit
+		WHERE signal_chat_id=$1 AND signal_receiver=$2 AND msg_sender=$3 AND msg_timestamp=$4
+	`
+)
+
+// Insert records that the message with the given timestamp was edited by a message with editTimestamp
+func (meq *MessageEditQuery) Insert(chatID, receiver, sender string, timestamp, editTimestamp uint64) {
+	_, err := meq.db.Exec(insertMessageEditQuery, chatID, receiver, sender, int64(timestamp), int64(editTimestamp))
+	if err != nil {
+		meq.log.Warnfln("Failed to insert edit %d of %s/%d: %v", editTimestamp, sender, timestamp, err)
+	}
+}
+
+// GetOriginalTimestamp returns the timestamp of the message an edit with the given timestamp was for,
+// or the timestamp itself if it isn't the timestamp of an edit.
+func (meq *MessageEditQuery) GetOriginalTimestamp(chatID, receiver, sender string, timestamp uint64) uint64 {
+	var originalTimestamp int64
+	err := meq.db.QueryRow(getEditedMessageTimestampQuery, chatID, receiver, sender, int64(timestamp)).Scan(&originalTimestamp)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			meq.log.Warnfln("Failed to query edit %s/%d: %v", sender, timestamp, err)
+		}
+		return timestamp
+	}
+	return uint64(originalTimestamp)
+}
+
+// GetLatestTimestamp returns the timestamp of the latest edit of the message with the given timestamp,
+// or the timestamp itself if the message hasn't been edited. New edits have to target this timestamp.
+func (meq *MessageEditQuery) GetLatestTimestamp(chatID, receiver, sender string, timestamp uint64) uint64 {
+	var latestTimestamp int64
+	err := meq.db.QueryRow(getLatestEditTimestampQuery, chatID, receiver, sender, int64(timestamp)).Scan(&latestTimestamp)
+	if err != nil {
+		meq.log.Warnfln("Failed to query latest edit of %s/%d: %v", sender, timestamp, err)
+		return timestamp
+	}
+	return uint64(latestTimestamp)
+}
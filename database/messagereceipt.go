@@ -0,0 +1,69 @@
+package database
+
+import (
+	log "maunium.net/go/maulogger/v2"
+)
+
+type MessageReceiptQuery struct {
+	db  *Database
+	log log.Logger
+}
+
+const (
+	// A read receipt also means the message was delivered, even if the delivery receipt never came
+	markMessageDeliveredQuery = `
+		INSERT INTO message_receipt (signal_chat_id, signal_receiver, msg_sender, msg_timestamp, msg_part_index, recipient, delivered_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (signal_chat_id, signal_receiver, msg_sender, msg_timestamp, msg_part_index, recipient)
+		DO UPDATE SET delivered_at=COALESCE(message_receipt.delivered_at, excluded.delivered_at)
+	`
+	markMessageReadQuery = `
+		INSERT INTO message_receipt (signal_chat_id, signal_receiver, msg_sender, msg_timestamp, msg_part_index, recipient, delivered_at, read_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		ON CONFLICT (signal_chat_id, signal_receiver, msg_sender, msg_timestamp, msg_part_index, recipient)
+		DO UPDATE SET delivered_at=COALESCE(message_receipt.delivered_at, excluded.delivered_at),
+		              read_at=COALESCE(message_receipt.read_at, excluded.read_at)
+	`
+	getMessageDeliveredToQuery = `
+		SELECT recipient FROM message_receipt
+		WHERE signal_chat_id=$1 AND signal_receiver=$2 AND msg_sender=$3 AND msg_timestamp=$4 AND msg_part_index=$5
+		      AND delivered_at IS NOT NULL
+	`
+)
+
+// MarkDelivered records that the recipient received the message at the given time.
+// An earlier delivery time isn't overwritten.
+func (mrq *MessageReceiptQuery) MarkDelivered(msg *Message, recipient string, timestamp uint64) {
+	_, err := mrq.db.Exec(markMessageDeliveredQuery, msg.SignalChatID, msg.SignalReceiver, msg.Sender, int64(msg.Timestamp), msg.PartIndex, recipient, int64(timestamp))
+	if err != nil {
+		mrq.log.Warnfln("Failed to mark %s/%d as delivered to %s: %v", msg.Sender, msg.Timestamp, recipient, err)
+	}
+}
+
+// MarkRead records that the recipient read the message at the given time, which also marks it as delivered.
+func (mrq *MessageReceiptQuery) MarkRead(msg *Message, recipient string, timestamp uint64) {
+	_, err := mrq.db.Exec(markMessageReadQuery, msg.SignalChatID, msg.SignalReceiver, msg.Sender, int64(msg.Timestamp), msg.PartIndex, recipient, int64(timestamp))
+	if err != nil {
+		mrq.log.Warnfln("Failed to mark %s/%d as read by %s: %v", msg.Sender, msg.Timestamp, recipient, err)
+	}
+}
+
+// GetDeliveredTo returns the UUIDs of the recipients who have received the message
+func (mrq *MessageReceiptQuery) GetDeliveredTo(msg *Message) (recipients []string) {
+	rows, err := mrq.db.Query(getMessageDeliveredToQuery, msg.SignalChatID, msg.SignalReceiver, msg.Sender, int64(msg.Timestamp), msg.PartIndex)
+	if err != nil || rows == nil {
+		mrq.log.Warnfln("Failed to query receipts of %s/%d: %v", msg.Sender, msg.Timestamp, err)
+		return nil
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var recipient string
+		err = rows.Scan(&recipient)
+		if err != nil {
+			mrq.log.Warnfln("Failed to scan receipt recipient: %v", err)
+			continue
+		}
+		recipients = append(recipients, recipient)
+	}
+	return
+}
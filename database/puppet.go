@@ -27,14 +27,15 @@ type Puppet struct {
 	db  *Database
 	log log.Logger
 
-	SignalID    string
-	Number      *string
-	Name        string
-	NameQuality int
-	AvatarHash  string
-	AvatarURL   id.ContentURI
-	NameSet     bool
-	AvatarSet   bool
+	SignalID      string
+	Number        *string
+	Name          string
+	NameQuality   int
+	AvatarHash    string
+	AvatarURL     id.ContentURI
+	AvatarQuality int
+	NameSet       bool
+	AvatarSet     bool
 
 	IsRegistered bool
 
@@ -61,6 +62,7 @@ func (p *Puppet) values() []interface{} {
 		p.AccessToken,
 		p.NextBatch,
 		p.BaseURL,
+		p.AvatarQuality,
 	}
 }
 
@@ -81,6 +83,7 @@ func (p *Puppet) Scan(row dbutil.Scannable) *Puppet {
 		&accessToken,
 		&nextBatch,
 		&baseURL,
+		&p.AvatarQuality,
 	)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
@@ -122,9 +125,9 @@ func (p *Puppet) Insert() error {
 	q := `
 	INSERT INTO puppet (uuid, number, name, name_quality, avatar_hash, avatar_url,
 						name_set, avatar_set, contact_info_set, is_registered,
-						custom_mxid, access_token, next_batch, base_url)
+						custom_mxid, access_token, next_batch, base_url, avatar_quality)
 	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
-			$11, $12, $13, $14)
+			$11, $12, $13, $14, $15)
 	`
 	tx, err := p.db.Begin()
 	if err != nil {
@@ -173,7 +176,7 @@ func (p *Puppet) Update() error {
 	UPDATE puppet SET
 		number=$2, name=$3, name_quality=$4, avatar_hash=$5, avatar_url=$6,
 		name_set=$7, avatar_set=$8, contact_info_set=$9, is_registered=$10,
-		custom_mxid=$11, access_token=$12, next_batch=$13, base_url=$14
+		custom_mxid=$11, access_token=$12, next_batch=$13, base_url=$14, avatar_quality=$15
 	WHERE uuid=$1
 	`
 	// check for db
@@ -190,7 +193,8 @@ func (p *Puppet) Update() error {
 const (
 	selectBase = `
         SELECT uuid, number, name, name_quality, avatar_hash, avatar_url, name_set, avatar_set,
-               contact_info_set, is_registered, custom_mxid, access_token, next_batch, base_url
+               contact_info_set, is_registered, custom_mxid, access_token, next_batch, base_url,
+               avatar_quality
         FROM puppet
 	`
 )
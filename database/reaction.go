@@ -34,6 +34,7 @@ type Reaction struct {
 	Author       string
 	MsgAuthor    string
 	MsgTimestamp uint64
+	MsgPartIndex int
 	Emoji        string
 }
 
@@ -42,12 +43,12 @@ func (r *Reaction) Insert(txn dbutil.Execable) {
 		txn = r.db
 	}
 	_, err := txn.Exec(`
-		INSERT INTO reaction (mxid, mx_room, signal_chat_id, signal_receiver, author, msg_author, msg_timestamp, emoji)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO reaction (mxid, mx_room, signal_chat_id, signal_receiver, author, msg_author, msg_timestamp, msg_part_index, emoji)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`,
-		r.MXID.String(), r.MXRoom, r.SignalChatID, r.SignalReceiver, r.Author, r.MsgAuthor, r.MsgTimestamp, r.Emoji,
+		r.MXID.String(), r.MXRoom, r.SignalChatID, r.SignalReceiver, r.Author, r.MsgAuthor, r.MsgTimestamp, r.MsgPartIndex, r.Emoji,
 	)
-	r.log.Debugfln("Inserting reaction", r.MXID, r.MXRoom, r.SignalChatID, r.SignalReceiver, r.Author, r.MsgAuthor, r.MsgTimestamp, r.Emoji)
+	r.log.Debugfln("Inserting reaction", r.MXID, r.MXRoom, r.SignalChatID, r.SignalReceiver, r.Author, r.MsgAuthor, r.MsgTimestamp, r.MsgPartIndex, r.Emoji)
 	if err != nil {
 		r.log.Warnfln("Failed to insert %s, %s: %v", r.SignalChatID, r.MXID, err)
 	}
@@ -69,7 +70,7 @@ func (r *Reaction) Delete(txn dbutil.Execable) {
 }
 
 func (r *Reaction) Scan(row dbutil.Scannable) *Reaction {
-	err := row.Scan(&r.MXID, &r.MXRoom, &r.SignalChatID, &r.SignalReceiver, &r.Author, &r.MsgAuthor, &r.MsgTimestamp, &r.Emoji)
+	err := row.Scan(&r.MXID, &r.MXRoom, &r.SignalChatID, &r.SignalReceiver, &r.Author, &r.MsgAuthor, &r.MsgTimestamp, &r.MsgPartIndex, &r.Emoji)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			r.log.Errorln("Database scan failed:", err)
@@ -88,7 +89,7 @@ func (rq *ReactionQuery) maybeScan(row *sql.Row) *Reaction {
 
 func (rq *ReactionQuery) GetByMXID(mxid id.EventID, roomID id.RoomID) *Reaction {
 	const getReactionByMXIDQuery = `
-		SELECT mxid, mx_room, signal_chat_id, signal_receiver, author, msg_author, msg_timestamp, emoji FROM reaction
+		SELECT mxid, mx_room, signal_chat_id, signal_receiver, author, msg_author, msg_timestamp, msg_part_index, emoji FROM reaction
 		WHERE mxid=$1 and mx_room=$2
 	`
 	return rq.maybeScan(rq.db.QueryRow(getReactionByMXIDQuery, mxid, roomID))
@@ -96,7 +97,7 @@ func (rq *ReactionQuery) GetByMXID(mxid id.EventID, roomID id.RoomID) *Reaction
 
 func (rq *ReactionQuery) GetBySignalID(signalChatID string, signalReceiver string, author string, msgAuthor string, msgTimestamp uint64) *Reaction {
 	const getReactionBySignalIDQuery = `
-		SELECT mxid, mx_room, signal_chat_id, signal_receiver, author, msg_author, msg_timestamp, emoji FROM reaction
+		SELECT mxid, mx_room, signal_chat_id, signal_receiver, author, msg_author, msg_timestamp, msg_part_index, emoji FROM reaction
         WHERE signal_chat_id=$1 AND signal_receiver=$2 AND author=$3 AND msg_author=$4 AND msg_timestamp=$5
 	`
 	return rq.maybeScan(rq.db.QueryRow(getReactionBySignalIDQuery, signalChatID, signalReceiver, author, msgAuthor, msgTimestamp))
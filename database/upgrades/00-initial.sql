@@ -1,4 +1,4 @@
--- v0 -> v14: Latest revision
+-- v0 -> v19: Latest revision
 
 CREATE TABLE portal (
     chat_id     TEXT,
@@ -25,6 +25,7 @@ CREATE TABLE puppet (
     name_quality INTEGER NOT NULL DEFAULT 0,
     avatar_hash  TEXT,
     avatar_url   TEXT,
+    avatar_quality INTEGER NOT NULL DEFAULT 0,
     name_set     BOOLEAN NOT NULL DEFAULT false,
     avatar_set   BOOLEAN NOT NULL DEFAULT false,
 
@@ -49,36 +50,65 @@ CREATE TABLE message (
     mx_room TEXT NOT NULL,
     sender          UUID,
     timestamp       BIGINT,
+    part_index      SMALLINT NOT NULL DEFAULT 0,
     signal_chat_id  TEXT,
     signal_receiver TEXT,
 
-    PRIMARY KEY (sender, timestamp, signal_chat_id, signal_receiver),
+    PRIMARY KEY (sender, timestamp, part_index, signal_chat_id, signal_receiver),
     FOREIGN KEY (signal_chat_id, signal_receiver) REFERENCES portal(chat_id, receiver) ON DELETE CASCADE,
     FOREIGN KEY (sender) REFERENCES puppet(uuid) ON DELETE CASCADE,
     UNIQUE (mxid, mx_room)
 );
 
 CREATE TABLE reaction (
-    mxid            TEXT   NOT NULL,
-    mx_room         TEXT   NOT NULL,
+    mxid            TEXT     NOT NULL,
+    mx_room         TEXT     NOT NULL,
 
-    signal_chat_id  TEXT   NOT NULL,
-    signal_receiver TEXT   NOT NULL,
+    signal_chat_id  TEXT     NOT NULL,
+    signal_receiver TEXT     NOT NULL,
 
-    author          UUID   NOT NULL,
-    msg_author      UUID   NOT NULL,
-    msg_timestamp   BIGINT NOT NULL,
-    emoji           TEXT   NOT NULL,
+    author          UUID     NOT NULL,
+    msg_author      UUID     NOT NULL,
+    msg_timestamp   BIGINT   NOT NULL,
+    msg_part_index  SMALLINT NOT NULL DEFAULT 0,
+    emoji           TEXT     NOT NULL,
 
     PRIMARY KEY (signal_chat_id, signal_receiver, msg_author, msg_timestamp, author),
     CONSTRAINT reaction_message_fkey
-    FOREIGN KEY (msg_author, msg_timestamp, signal_chat_id, signal_receiver)
-    REFERENCES message(sender, timestamp, signal_chat_id, signal_receiver)
+    FOREIGN KEY (msg_author, msg_timestamp, msg_part_index, signal_chat_id, signal_receiver)
+    REFERENCES message(sender, timestamp, part_index, signal_chat_id, signal_receiver)
     ON DELETE CASCADE,
     FOREIGN KEY (author) REFERENCES puppet(uuid) ON DELETE CASCADE,
     UNIQUE (mxid, mx_room)
 );
 
+CREATE TABLE message_receipt (
+    signal_chat_id  TEXT     NOT NULL,
+    signal_receiver TEXT     NOT NULL,
+    msg_sender      UUID     NOT NULL,
+    msg_timestamp   BIGINT   NOT NULL,
+    msg_part_index  SMALLINT NOT NULL DEFAULT 0,
+    recipient       UUID     NOT NULL,
+    delivered_at    BIGINT,
+    read_at         BIGINT,
+
+    PRIMARY KEY (signal_chat_id, signal_receiver, msg_sender, msg_timestamp, msg_part_index, recipient),
+    FOREIGN KEY (msg_sender, msg_timestamp, msg_part_index, signal_chat_id, signal_receiver)
+    REFERENCES message(sender, timestamp, part_index, signal_chat_id, signal_receiver)
+    ON DELETE CASCADE
+);
+
+CREATE TABLE message_edit (
+    signal_chat_id  TEXT   NOT NULL,
+    signal_receiver TEXT   NOT NULL,
+    msg_sender      UUID   NOT NULL,
+    msg_timestamp   BIGINT NOT NULL,
+    edit_timestamp  BIGINT NOT NULL,
+
+    PRIMARY KEY (signal_chat_id, signal_receiver, msg_sender, edit_timestamp),
+    FOREIGN KEY (signal_chat_id, signal_receiver) REFERENCES portal(chat_id, receiver) ON DELETE CASCADE
+);
+
 CREATE TABLE disappearing_message (
     room_id             TEXT,
     mxid                TEXT,
@@ -87,3 +117,12 @@ CREATE TABLE disappearing_message (
 
     PRIMARY KEY (room_id, mxid)
 );
+
+CREATE TABLE view_once_message (
+    room_id   TEXT   NOT NULL,
+    mxid      TEXT   NOT NULL,
+    sender    UUID   NOT NULL,
+    timestamp BIGINT NOT NULL,
+
+    PRIMARY KEY (room_id, mxid)
+);
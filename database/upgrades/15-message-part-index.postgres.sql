@@ -0,0 +1,11 @@
+-- v15: Store the part index of messages that were split into several Matrix events
+ALTER TABLE message ADD COLUMN part_index SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE reaction ADD COLUMN msg_part_index SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE reaction DROP CONSTRAINT reaction_message_fkey;
+ALTER TABLE message DROP CONSTRAINT message_pkey;
+ALTER TABLE message ADD PRIMARY KEY (sender, timestamp, part_index, signal_chat_id, signal_receiver);
+ALTER TABLE reaction ADD CONSTRAINT reaction_message_fkey
+    FOREIGN KEY (msg_author, msg_timestamp, msg_part_index, signal_chat_id, signal_receiver)
+    REFERENCES message(sender, timestamp, part_index, signal_chat_id, signal_receiver)
+    ON DELETE CASCADE;
@@ -0,0 +1,47 @@
+-- v15: Store the part index of messages that were split into several Matrix events
+ALTER TABLE reaction RENAME TO reaction_old;
+ALTER TABLE message RENAME TO message_old;
+
+CREATE TABLE message (
+    mxid    TEXT NOT NULL,
+    mx_room TEXT NOT NULL,
+    sender          UUID,
+    timestamp       BIGINT,
+    part_index      SMALLINT NOT NULL DEFAULT 0,
+    signal_chat_id  TEXT,
+    signal_receiver TEXT,
+
+    PRIMARY KEY (sender, timestamp, part_index, signal_chat_id, signal_receiver),
+    FOREIGN KEY (signal_chat_id, signal_receiver) REFERENCES portal(chat_id, receiver) ON DELETE CASCADE,
+    FOREIGN KEY (sender) REFERENCES puppet(uuid) ON DELETE CASCADE,
+    UNIQUE (mxid, mx_room)
+);
+INSERT INTO message (mxid, mx_room, sender, timestamp, part_index, signal_chat_id, signal_receiver)
+SELECT mxid, mx_room, sender, timestamp, 0, signal_chat_id, signal_receiver FROM message_old;
+
+CREATE TABLE reaction (
+    mxid            TEXT     NOT NULL,
+    mx_room         TEXT     NOT NULL,
+
+    signal_chat_id  TEXT     NOT NULL,
+    signal_receiver TEXT     NOT NULL,
+
+    author          UUID     NOT NULL,
+    msg_author      UUID     NOT NULL,
+    msg_timestamp   BIGINT   NOT NULL,
+    msg_part_index  SMALLINT NOT NULL DEFAULT 0,
+    emoji           TEXT     NOT NULL,
+
+    PRIMARY KEY (signal_chat_id, signal_receiver, msg_author, msg_timestamp, author),
+    CONSTRAINT reaction_message_fkey
+    FOREIGN KEY (msg_author, msg_timestamp, msg_part_index, signal_chat_id, signal_receiver)
+    REFERENCES message(sender, timestamp, part_index, signal_chat_id, signal_receiver)
+    ON DELETE CASCADE,
+    FOREIGN KEY (author) REFERENCES puppet(uuid) ON DELETE CASCADE,
+    UNIQUE (mxid, mx_room)
+);
+INSERT INTO reaction (mxid, mx_room, signal_chat_id, signal_receiver, author, msg_author, msg_timestamp, msg_part_index, emoji)
+SELECT mxid, mx_room, signal_chat_id, signal_receiver, author, msg_author, msg_timestamp, 0, emoji FROM reaction_old;
+
+DROP TABLE reaction_old;
+DROP TABLE message_old;
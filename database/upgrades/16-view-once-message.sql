@@ -0,0 +1,9 @@
+-- v16: Track bridged view-once media so it can be removed once it's viewed on Matrix
+CREATE TABLE view_once_message (
+    room_id   TEXT   NOT NULL,
+    mxid      TEXT   NOT NULL,
+    sender    UUID   NOT NULL,
+    timestamp BIGINT NOT NULL,
+
+    PRIMARY KEY (room_id, mxid)
+);
@@ -0,0 +1,2 @@
+-- v17: Remember where puppet avatars came from, so a contact list avatar isn't removed when the profile has none
+ALTER TABLE puppet ADD COLUMN avatar_quality INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,16 @@
+-- v18: Track which Signal users have received and read the messages sent from Matrix
+CREATE TABLE message_receipt (
+    signal_chat_id  TEXT     NOT NULL,
+    signal_receiver TEXT     NOT NULL,
+    msg_sender      UUID     NOT NULL,
+    msg_timestamp   BIGINT   NOT NULL,
+    msg_part_index  SMALLINT NOT NULL DEFAULT 0,
+    recipient       UUID     NOT NULL,
+    delivered_at    BIGINT,
+    read_at         BIGINT,
+
+    PRIMARY KEY (signal_chat_id, signal_receiver, msg_sender, msg_timestamp, msg_part_index, recipient),
+    FOREIGN KEY (msg_sender, msg_timestamp, msg_part_index, signal_chat_id, signal_receiver)
+    REFERENCES message(sender, timestamp, part_index, signal_chat_id, signal_receiver)
+    ON DELETE CASCADE
+);
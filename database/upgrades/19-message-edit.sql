@@ -0,0 +1,11 @@
+-- v19: Remember the timestamps of message edits, since later edits target the previous edit
+CREATE TABLE message_edit (
+    signal_chat_id  TEXT   NOT NULL,
+    signal_receiver TEXT   NOT NULL,
+    msg_sender      UUID   NOT NULL,
+    msg_timestamp   BIGINT NOT NULL,
+    edit_timestamp  BIGINT NOT NULL,
+
+    PRIMARY KEY (signal_chat_id, signal_receiver, msg_sender, edit_timestamp),
+    FOREIGN KEY (signal_chat_id, signal_receiver) REFERENCES portal(chat_id, receiver) ON DELETE CASCADE
+);
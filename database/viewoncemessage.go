@@ -0,0 +1,112 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+
+	"go.mau.fi/util/dbutil"
+	log "maunium.net/go/maulogger/v2"
+	"maunium.net/go/mautrix/id"
+)
+
+type ViewOnceMessageQuery struct {
+	db  *Database
+	log log.Logger
+}
+
+func (vmq *ViewOnceMessageQuery) New() *ViewOnceMessage {
+	return &ViewOnceMessage{
+		db:  vmq.db,
+		log: vmq.log,
+	}
+}
+
+func (vmq *ViewOnceMessageQuery) NewWithValues(roomID id.RoomID, eventID id.EventID, sender string, timestamp uint64) *ViewOnceMessage {
+	vm := vmq.New()
+	vm.RoomID = roomID
+	vm.EventID = eventID
+	vm.Sender = sender
+	vm.Timestamp = timestamp
+	return vm
+}
+
+// ViewOnceMessage is a Matrix event with view-once media from Signal that hasn't been viewed yet.
+type ViewOnceMessage struct {
+	db  *Database
+	log log.Logger
+
+	RoomID    id.RoomID
+	EventID   id.EventID
+	Sender    string
+	Timestamp uint64
+}
+
+const (
+	getViewOnceMessagesUpToQuery = `
+		SELECT room_id, mxid, sender, timestamp FROM view_once_message
+		WHERE room_id=$1 AND timestamp <= $2
+	`
+	getViewOnceMessagesBySignalIDQuery = `
+		SELECT room_id, mxid, sender, timestamp FROM view_once_message
+		WHERE room_id=$1 AND sender=$2 AND timestamp=$3
+	`
+)
+
+func (vm *ViewOnceMessage) Insert(txn dbutil.Execable) {
+	if txn == nil {
+		txn = vm.db
+	}
+	_, err := txn.Exec(`
+		INSERT INTO view_once_message (room_id, mxid, sender, timestamp)
+		VALUES ($1, $2, $3, $4)
+	`, vm.RoomID, vm.EventID, vm.Sender, int64(vm.Timestamp))
+	if err != nil {
+		vm.log.Warnfln("Failed to insert view-once message %s/%s: %v", vm.RoomID, vm.EventID, err)
+	}
+}
+
+func (vm *ViewOnceMessage) Delete() {
+	_, err := vm.db.Exec("DELETE FROM view_once_message WHERE room_id=$1 AND mxid=$2", vm.RoomID, vm.EventID)
+	if err != nil {
+		vm.log.Warnfln("Failed to delete view-once message %s/%s: %v", vm.RoomID, vm.EventID, err)
+	}
+}
+
+func (vm *ViewOnceMessage) Scan(row dbutil.Scannable) *ViewOnceMessage {
+	var timestamp int64
+	err := row.Scan(&vm.RoomID, &vm.EventID, &vm.Sender, &timestamp)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			vm.log.Errorln("Database scan failed:", err)
+		}
+		return nil
+	}
+	vm.Timestamp = uint64(timestamp)
+	return vm
+}
+
+func (vmq *ViewOnceMessageQuery) getAll(query string, args ...interface{}) (messages []*ViewOnceMessage) {
+	rows, err := vmq.db.Query(query, args...)
+	if err != nil || rows == nil {
+		vmq.log.Warnfln("Failed to query view-once messages: %v", err)
+		return nil
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if vm := vmq.New().Scan(rows); vm != nil {
+			messages = append(messages, vm)
+		}
+	}
+	return
+}
+
+// GetUpTo returns the view-once messages in the room that were sent at or before the given Signal timestamp,
+// i.e. the ones a read receipt for a message with that timestamp covers.
+func (vmq *ViewOnceMessageQuery) GetUpTo(roomID id.RoomID, timestamp uint64) []*ViewOnceMessage {
+	return vmq.getAll(getViewOnceMessagesUpToQuery, roomID, int64(timestamp))
+}
+
+// GetBySignalID returns the unviewed events of a view-once Signal message in the room
+func (vmq *ViewOnceMessageQuery) GetBySignalID(roomID id.RoomID, sender string, timestamp uint64) []*ViewOnceMessage {
+	return vmq.getAll(getViewOnceMessagesBySignalIDQuery, roomID, sender, int64(timestamp))
+}
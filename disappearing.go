@@ -0,0 +1,153 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"maunium.net/go/mautrix/id"
+
+	"go.mau.fi/mautrix-signal/database"
+)
+
+// How often the database is checked for disappearing messages that are about to expire
+const disappearingMessageCheckInterval = 1 * time.Hour
+
+// MarkDisappearing stores a bridged event to be redacted once its Signal disappearing timer runs out.
+// Signal starts the timer when a message is sent for the sender, and when it is read for everyone else.
+func (portal *Portal) MarkDisappearing(eventID id.EventID, expiresIn uint32, startNow bool) {
+	if expiresIn == 0 || eventID == "" {
+		return
+	}
+	expireIn := time.Duration(expiresIn) * time.Second
+	var expireAt time.Time
+	if startNow {
+		expireAt = time.Now().Add(expireIn)
+	}
+	msg := portal.bridge.DB.DisappearingMessage.NewWithValues(portal.MXID, eventID, expireIn, expireAt)
+	msg.Insert(nil)
+	if startNow && expireIn < disappearingMessageCheckInterval {
+		go portal.sleepAndDelete(msg)
+	}
+}
+
+// startDisappearingTimers starts the timers of the disappearing messages in the room that were
+// waiting for the user to read them, up to the message with the given timestamp that was read.
+func (portal *Portal) startDisappearingTimers(upToTimestamp uint64) {
+	if portal.MXID == "" {
+		return
+	}
+	for _, msg := range portal.bridge.DB.DisappearingMessage.GetUnstartedUpTo(portal.MXID, upToTimestamp) {
+		msg.StartTimer()
+		if msg.ExpireIn < disappearingMessageCheckInterval {
+			go portal.sleepAndDelete(msg)
+		}
+	}
+}
+
+// SleepAndDeleteUpcoming periodically schedules the redaction of disappearing messages
+// that will expire before the next check. Messages that expired while the bridge was
+// offline are redacted right away.
+func (br *SignalBridge) SleepAndDeleteUpcoming() {
+	for {
+		for _, msg := range br.DB.DisappearingMessage.GetUpcomingScheduled(disappearingMessageCheckInterval) {
+			portal := br.GetPortalByMXID(msg.RoomID)
+			if portal == nil {
+				msg.Delete()
+				continue
+			}
+			go portal.sleepAndDelete(msg)
+		}
+		time.Sleep(disappearingMessageCheckInterval)
+	}
+}
+
+func (portal *Portal) sleepAndDelete(msg *database.DisappearingMessage) {
+	if _, alreadySleeping := portal.disappearingSleepers.LoadOrStore(msg.EventID, struct{}{}); alreadySleeping {
+		return
+	}
+	defer portal.disappearingSleepers.Delete(msg.EventID)
+
+	time.Sleep(time.Until(msg.ExpireAt))
+	// Make sure the message wasn't already handled (e.g. by a previous sleeper)
+	if portal.bridge.DB.DisappearingMessage.GetByMXID(msg.RoomID, msg.EventID) == nil {
+		return
+	}
+	_, err := portal.MainIntent().RedactEvent(msg.RoomID, msg.EventID)
+	if err != nil {
+		portal.log.Warn().Err(err).Msgf("Failed to redact disappearing message %s", msg.EventID)
+	} else {
+		portal.log.Debug().Msgf("Redacted disappearing message %s", msg.EventID)
+	}
+	msg.Delete()
+}
+
+// formatDisappearingTimer formats a disappearing message timer the way Signal clients show it,
+// e.g. "1 week" or "1 day, 8 hours".
+func formatDisappearingTimer(seconds uint32) string {
+	units := []struct {
+		name    string
+		seconds uint32
+	}{
+		{"week", 7 * 24 * 60 * 60},
+		{"day", 24 * 60 * 60},
+		{"hour", 60 * 60},
+		{"minute", 60},
+		{"second", 1},
+	}
+	var parts []string
+	for _, unit := range units {
+		count := seconds / unit.seconds
+		if count == 0 {
+			continue
+		}
+		seconds -= count * unit.seconds
+		if count == 1 {
+			parts = append(parts, fmt.Sprintf("1 %s", unit.name))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d %ss", count, unit.name))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// parseDisappearingTimer parses a disappearing message timer given as "off", a number of seconds,
+// or a duration like "30m", "8h", "1d" or "1w".
+func parseDisappearingTimer(input string) (uint32, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "off" || input == "0" {
+		return 0, nil
+	}
+	if seconds, err := strconv.ParseUint(input, 10, 32); err == nil {
+		return uint32(seconds), nil
+	}
+	var duration time.Duration
+	var err error
+	if days, found := strings.CutSuffix(input, "d"); found {
+		duration, err = parseDurationUnits(days, 24*time.Hour)
+	} else if weeks, found := strings.CutSuffix(input, "w"); found {
+		duration, err = parseDurationUnits(weeks, 7*24*time.Hour)
+	} else {
+		duration, err = time.ParseDuration(input)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if duration < time.Second {
+		return 0, errors.New("duration must be at least one second")
+	} else if duration.Seconds() > math.MaxUint32 {
+		return 0, errors.New("duration is too long")
+	}
+	return uint32(duration.Seconds()), nil
+}
+
+func parseDurationUnits(count string, unit time.Duration) (time.Duration, error) {
+	n, err := strconv.ParseUint(count, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", count)
+	}
+	return time.Duration(n) * unit, nil
+}
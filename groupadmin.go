@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"go.mau.fi/util/exerrors"
+	"maunium.net/go/mautrix/appservice"
+	"maunium.net/go/mautrix/bridge"
+	"maunium.net/go/mautrix/bridge/bridgeconfig"
+	"maunium.net/go/mautrix/bridge/status"
+	"maunium.net/go/mautrix/event"
+	"maunium.net/go/mautrix/id"
+
+	"go.mau.fi/mautrix-signal/pkg/signalmeow"
+)
+
+// Matrix state events that are bridged to Signal group changes, other than the room metadata
+// that the bridge library passes to HandleMatrixMeta
+var groupAdminEventTypes = []event.Type{
+	event.StateMember,
+	event.StatePowerLevels,
+}
+
+// HandleMatrixGroupAdminEvent queues membership and power level changes in group portals to be bridged
+// to Signal. The bridge library doesn't pass power levels on to portals at all, and passes memberships
+// without the event, so bans can't be told apart from kicks.
+func (br *SignalBridge) HandleMatrixGroupAdminEvent(evt *event.Event) {
+	if evt.Sender == br.Bot.UserID || br.IsGhost(evt.Sender) {
+		return
+	}
+	// Changes we made ourselves with the double puppet are echoes of Signal changes
+	if val, ok := evt.Content.Raw[appservice.DoublePuppetKey]; ok && val == br.Name {
+		return
+	}
+	user := br.GetUserByMXID(evt.Sender)
+	if user == nil || user.GetPermissionLevel() < bridgeconfig.PermissionLevelUser || !user.IsLoggedIn() {
+		return
+	}
+	portal := br.GetPortalByMXID(evt.RoomID)
+	if portal == nil || portal.IsPrivateChat() || portal.IsStories() {
+		return
+	}
+	portal.matrixMessages <- portalMatrixMessage{user: user, evt: evt}
+}
+
+// mautrix-go MetaHandlingPortal interface
+func (portal *Portal) HandleMatrixMeta(sender bridge.User, evt *event.Event) {
+	user := sender.(*User)
+	if user.GetPermissionLevel() < bridgeconfig.PermissionLevelUser || !user.IsLoggedIn() || portal.IsStories() {
+		return
+	}
+	portal.matrixMessages <- portalMatrixMessage{user: user, evt: evt}
+}
+
+// sendGroupAdminStatus reports whether a Matrix state event was applied to the Signal group
+func (portal *Portal) sendGroupAdminStatus(evt *event.Event, action string, err error) {
+	if err != nil {
+		portal.log.Err(err).Msgf("Failed to bridge %s %s to Signal", action, evt.ID)
+		portal.bridge.SendMessageErrorCheckpoint(evt, status.MsgStepRemote, err, true, 0)
+		portal.sendErrorMessage(evt, err, action, true, "")
+	} else {
+		portal.log.Debug().Msgf("Bridged %s %s to Signal", action, evt.ID)
+		portal.bridge.SendMessageSuccessCheckpoint(evt, status.MsgStepRemote, 0)
+	}
+	portal.sendStatusEvent(evt.ID, "", err, nil)
+}
+
+// handleMatrixMembership bridges kicks, bans, unbans and invites of Signal users
+func (portal *Portal) handleMatrixMembership(sender *User, evt *event.Event) {
+	signalID, isPuppet := portal.bridge.ParsePuppetMXID(id.UserID(evt.GetStateKey()))
+	if !isPuppet || signalID == sender.SignalID {
+		return
+	}
+	content := evt.Content.AsMember()
+	prevMembership := event.MembershipLeave
+	if evt.Unsigned.PrevContent != nil {
+		_ = evt.Unsigned.PrevContent.ParseRaw(evt.Type)
+		if prevContent, ok := evt.Unsigned.PrevContent.Parsed.(*event.MemberEventContent); ok {
+			prevMembership = prevContent.Membership
+		}
+	}
+	if content.Membership == prevMembership {
+		return
+	}
+
+	var action string
+	var changeGroup func(ctx context.Context, d *signalmeow.Device, gid signalmeow.GroupIdentifier, userID string) error
+	switch {
+	case content.Membership == event.MembershipBan:
+		action, changeGroup = "ban", signalmeow.BanGroupMember
+	case content.Membership == event.MembershipLeave && prevMembership == event.MembershipBan:
+		action, changeGroup = "unban", signalmeow.UnbanGroupMember
+	case content.Membership == event.MembershipLeave && evt.Sender.String() != evt.GetStateKey():
+		action, changeGroup = "kick", signalmeow.RemoveGroupMember
+	case content.Membership == event.MembershipInvite:
+		action, changeGroup = "invite", signalmeow.InviteGroupMember
+	default:
+		return
+	}
+	if sender.SignalDevice == nil {
+		portal.sendGroupAdminStatus(evt, action, errUserNotConnected)
+		return
+	}
+	err := changeGroup(context.Background(), sender.SignalDevice, signalmeow.GroupIdentifier(portal.ChatID), signalID)
+	portal.sendGroupAdminStatus(evt, action, err)
+}
+
+// handleMatrixPowerLevels makes Signal members admins if their power level is raised to the admin level,
+// and regular members if it's lowered below it
+func (portal *Portal) handleMatrixPowerLevels(sender *User, evt *event.Event) {
+	const action = "power level change"
+	if sender.SignalDevice == nil {
+		portal.sendGroupAdminStatus(evt, action, errUserNotConnected)
+		return
+	}
+	ctx := context.Background()
+	gid := signalmeow.GroupIdentifier(portal.ChatID)
+	group, err := signalmeow.RetrieveGroupByID(ctx, sender.SignalDevice, gid)
+	if err != nil {
+		portal.sendGroupAdminStatus(evt, action, err)
+		return
+	}
+	content := evt.Content.AsPowerLevels()
+	roles := make(map[string]signalmeow.GroupMemberRole)
+	for _, member := range group.Members {
+		if member.UserId == sender.SignalID {
+			continue
+		}
+		isAdmin := member.Role == signalmeow.GroupMember_ADMINISTRATOR
+		shouldBeAdmin := content.GetUserLevel(portal.bridge.FormatPuppetMXID(member.UserId)) >= signalAdminPowerLevel
+		if isAdmin == shouldBeAdmin {
+			continue
+		}
+		role := signalmeow.GroupMember_DEFAULT
+		if shouldBeAdmin {
+			role = signalmeow.GroupMember_ADMINISTRATOR
+		}
+		roles[member.UserId] = role
+	}
+	if len(roles) > 0 {
+		err = signalmeow.SetGroupMemberRoles(ctx, sender.SignalDevice, gid, roles)
+		portal.sendGroupAdminStatus(evt, action, err)
+	}
+}
+
+// handleMatrixRoomMetadata bridges room name, topic and avatar changes to the group title, description and avatar
+func (portal *Portal) handleMatrixRoomMetadata(sender *User, evt *event.Event) {
+	ctx := context.Background()
+	gid := signalmeow.GroupIdentifier(portal.ChatID)
+	var action string
+	var err error
+	switch content := evt.Content.Parsed.(type) {
+	case *event.RoomNameEventContent:
+		if content.Name == portal.Name {
+			return
+		}
+		action = "room name change"
+		if sender.SignalDevice == nil {
+			err = errUserNotConnected
+			break
+		}
+		err = signalmeow.SetGroupTitle(ctx, sender.SignalDevice, gid, content.Name)
+		if err == nil {
+			portal.Name = content.Name
+			portal.NameSet = true
+		}
+	case *event.TopicEventContent:
+		if content.Topic == portal.Topic {
+			return
+		}
+		action = "room topic change"
+		if sender.SignalDevice == nil {
+			err = errUserNotConnected
+			break
+		}
+		err = signalmeow.SetGroupDescription(ctx, sender.SignalDevice, gid, content.Topic)
+		if err == nil {
+			portal.Topic = content.Topic
+		}
+	case *event.RoomAvatarEventContent:
+		if content.URL == portal.AvatarURL {
+			return
+		}
+		action = "room avatar change"
+		if sender.SignalDevice == nil {
+			err = errUserNotConnected
+			break
+		}
+		var avatar []byte
+		if !content.URL.IsEmpty() {
+			avatar, err = portal.MainIntent().DownloadBytes(content.URL)
+			if err != nil {
+				err = exerrors.NewDualError(errMediaDownloadFailed, err)
+				break
+			}
+		}
+		err = signalmeow.SetGroupAvatar(ctx, sender.SignalDevice, gid, avatar)
+		if err == nil {
+			portal.AvatarURL = content.URL
+			portal.AvatarHash = ""
+			if len(avatar) > 0 {
+				hash := sha256.Sum256(avatar)
+				portal.AvatarHash = hex.EncodeToString(hash[:])
+			}
+			portal.AvatarSet = true
+		}
+	default:
+		return
+	}
+	if err == nil {
+		if updateErr := portal.Update(); updateErr != nil {
+			portal.log.Err(updateErr).Msg("error updating portal")
+		}
+	}
+	portal.sendGroupAdminStatus(evt, action, err)
+}
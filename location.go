@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"maunium.net/go/mautrix/appservice"
+	"maunium.net/go/mautrix/event"
+
+	"go.mau.fi/mautrix-signal/pkg/signalmeow"
+)
+
+// Signal clients share locations as a text message with the address and a Google Maps link
+const signalMapsURLFormat = "https://maps.google.com/maps?q=%s%%2C%s"
+
+var locationPreviewClient = &http.Client{Timeout: 30 * time.Second}
+
+type location struct {
+	Lat  float64
+	Long float64
+}
+
+func (loc location) latString() string {
+	return strconv.FormatFloat(loc.Lat, 'f', -1, 64)
+}
+
+func (loc location) longString() string {
+	return strconv.FormatFloat(loc.Long, 'f', -1, 64)
+}
+
+func (loc location) GeoURI() string {
+	return fmt.Sprintf("geo:%s,%s", loc.latString(), loc.longString())
+}
+
+func (loc location) MapsURL() string {
+	return fmt.Sprintf(signalMapsURLFormat, loc.latString(), loc.longString())
+}
+
+func parseCoordinates(lat, long string) (location, bool) {
+	latValue, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	if err != nil || latValue < -90 || latValue > 90 {
+		return location{}, false
+	}
+	longValue, err := strconv.ParseFloat(strings.TrimSpace(long), 64)
+	if err != nil || longValue < -180 || longValue > 180 {
+		return location{}, false
+	}
+	return location{Lat: latValue, Long: longValue}, true
+}
+
+// parseCoordinatePair parses "lat,long", ignoring anything after a second comma (like a zoom level)
+func parseCoordinatePair(pair string) (location, bool) {
+	parts := strings.SplitN(pair, ",", 3)
+	if len(parts) < 2 {
+		return location{}, false
+	}
+	return parseCoordinates(parts[0], parts[1])
+}
+
+// parseGeoURI parses an RFC 5870 geo URI like geo:12.34,56.78;u=35
+func parseGeoURI(uri string) (location, error) {
+	coordinates, ok := strings.CutPrefix(uri, "geo:")
+	if !ok {
+		return location{}, fmt.Errorf("%w: missing geo: prefix", errInvalidGeoURI)
+	}
+	coordinates, _, _ = strings.Cut(coordinates, ";")
+	loc, ok := parseCoordinatePair(coordinates)
+	if !ok {
+		return location{}, fmt.Errorf("%w: bad coordinates %q", errInvalidGeoURI, coordinates)
+	}
+	return loc, nil
+}
+
+// parseMapsURL finds the coordinates in a Google Maps, Apple Maps or OpenStreetMap link
+func parseMapsURL(rawURL string) (location, bool) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return location{}, false
+	}
+	query := parsed.Query()
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	switch host {
+	case "maps.google.com", "google.com":
+		if host == "google.com" && !strings.HasPrefix(parsed.Path, "/maps") {
+			return location{}, false
+		}
+		for _, key := range []string{"q", "query", "ll", "center"} {
+			if loc, ok := parseCoordinatePair(query.Get(key)); ok {
+				return loc, true
+			}
+		}
+		// Links copied from the browser look like /maps/@12.34,56.78,15z
+		if _, after, found := strings.Cut(parsed.Path, "/@"); found {
+			return parseCoordinatePair(after)
+		}
+	case "maps.apple.com":
+		for _, key := range []string{"ll", "q", "sll"} {
+			if loc, ok := parseCoordinatePair(query.Get(key)); ok {
+				return loc, true
+			}
+		}
+	case "openstreetmap.org", "osm.org":
+		if loc, ok := parseCoordinates(query.Get("mlat"), query.Get("mlon")); ok {
+			return loc, true
+		}
+		// #map=zoom/lat/long
+		if mapFragment, ok := strings.CutPrefix(parsed.Fragment, "map="); ok {
+			parts := strings.Split(mapFragment, "/")
+			if len(parts) == 3 {
+				return parseCoordinates(parts[1], parts[2])
+			}
+		}
+	}
+	return location{}, false
+}
+
+// parseSignalLocation detects a text message that only shares a location: a maps link,
+// optionally preceded by the name or address of the place.
+func parseSignalLocation(text string) (description string, loc location, ok bool) {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	loc, ok = parseMapsURL(strings.TrimSpace(lines[len(lines)-1]))
+	if !ok {
+		return "", location{}, false
+	}
+	description = strings.TrimSpace(strings.Join(lines[:len(lines)-1], "\n"))
+	if strings.Contains(description, "://") {
+		// The description has other links, so it's probably not a location share
+		return "", location{}, false
+	}
+	return description, loc, true
+}
+
+// convertSignalLocation turns a Signal location share into m.location.
+// The static map preview Signal clients attach is used as the thumbnail.
+func (portal *Portal) convertSignalLocation(intent *appservice.IntentAPI, msg signalmeow.IncomingSignalMessageText) (*event.MessageEventContent, bool) {
+	if len(msg.Mentions) > 0 || len(msg.Styles) > 0 {
+		return nil, false
+	}
+	description, loc, ok := parseSignalLocation(msg.Content)
+	if !ok {
+		return nil, false
+	}
+	body := description
+	if body == "" {
+		body = "Location"
+	}
+	content := &event.MessageEventContent{
+		MsgType: event.MsgLocation,
+		Body:    fmt.Sprintf("%s: %s", body, loc.MapsURL()),
+		GeoURI:  loc.GeoURI(),
+	}
+	var previewImage *signalmeow.AttachmentPointer
+	for _, preview := range msg.Previews {
+		if preview.Image != nil {
+			previewImage = preview.Image
+			break
+		}
+	}
+	if previewImage != nil {
+		portal.addLocationThumbnail(intent, content, previewImage)
+	}
+	return content, true
+}
+
+func (portal *Portal) addLocationThumbnail(intent *appservice.IntentAPI, content *event.MessageEventContent, previewImage *signalmeow.AttachmentPointer) {
+	var buf bytes.Buffer
+	err := signalmeow.DownloadAttachment(previewImage, &buf, portal.bridge.Config.Bridge.MaxAttachmentSize())
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to download location preview")
+		return
+	}
+	thumbnail := buf.Bytes()
+	thumbnailInfo := &event.FileInfo{MimeType: http.DetectContentType(thumbnail), Size: len(thumbnail)}
+	uri, file, err := portal.uploadToMatrix(intent, thumbnail, thumbnailInfo.MimeType)
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to upload location preview")
+		return
+	}
+	content.Info = &event.FileInfo{ThumbnailInfo: thumbnailInfo}
+	if file != nil {
+		content.Info.ThumbnailFile = file
+	} else {
+		content.Info.ThumbnailURL = uri
+	}
+}
+
+// convertMatrixLocation turns m.location into a maps link the way Signal clients share locations,
+// with a static map as the link preview if the event has a thumbnail or a preview URL is configured.
+func (portal *Portal) convertMatrixLocation(ctx context.Context, sender *User, evt *event.Event, content *event.MessageEventContent) (*signalmeow.SignalContent, error) {
+	loc, err := parseGeoURI(content.GeoURI)
+	if err != nil {
+		return nil, err
+	}
+	var description string
+	if msc3488Location, ok := evt.Content.Raw["org.matrix.msc3488.location"].(map[string]interface{}); ok {
+		description, _ = msc3488Location["description"].(string)
+	}
+	if description == "" && !strings.Contains(content.Body, "geo:") {
+		description = content.Body
+	}
+	mapsURL := loc.MapsURL()
+	text := mapsURL
+	if description != "" {
+		text = description + "\n" + mapsURL
+	}
+	outgoingMessage := signalmeow.DataMessageForText(text, uint32(portal.ExpirationTime))
+
+	if preview := portal.getLocationPreview(ctx, sender, content, loc); preview != nil {
+		title := description
+		if title == "" {
+			title = "Location"
+		}
+		signalmeow.AddPreviewToDataMessage(outgoingMessage, mapsURL, title, "", preview)
+	}
+	return outgoingMessage, nil
+}
+
+func (portal *Portal) getLocationPreview(ctx context.Context, sender *User, content *event.MessageEventContent, loc location) *signalmeow.AttachmentPointer {
+	var data []byte
+	var err error
+	info := content.GetInfo()
+	if info.ThumbnailURL != "" || info.ThumbnailFile != nil {
+		thumbnailContent := &event.MessageEventContent{
+			URL:  info.ThumbnailURL,
+			File: info.ThumbnailFile,
+			Info: info.ThumbnailInfo,
+		}
+		data, err = portal.downloadAndDecryptMatrixMedia(ctx, thumbnailContent)
+	} else if previewURL := portal.bridge.Config.Bridge.FormatLocationPreviewURL(loc.latString(), loc.longString()); previewURL != "" {
+		data, err = portal.downloadLocationPreview(ctx, previewURL)
+	} else {
+		return nil
+	}
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to get location preview image")
+		return nil
+	}
+	mimeType := http.DetectContentType(data)
+	if !strings.HasPrefix(mimeType, "image/") {
+		portal.log.Warn().Str("mime_type", mimeType).Msg("Location preview isn't an image")
+		return nil
+	}
+	preview, err := signalmeow.UploadAttachment(sender.SignalDevice, data, mimeType, "")
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to upload location preview to Signal")
+		return nil
+	}
+	return preview
+}
+
+func (portal *Portal) downloadLocationPreview(ctx context.Context, previewURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, previewURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := locationPreviewClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	maxSize := portal.bridge.Config.Bridge.MaxAttachmentSize()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	} else if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w (more than %d bytes)", errMediaTooLarge, maxSize)
+	}
+	return data, nil
+}
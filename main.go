@@ -74,6 +74,9 @@ func (br *SignalBridge) GetConfigPtr() interface{} {
 func (br *SignalBridge) Init() {
 	br.CommandProcessor = commands.NewProcessor(&br.Bridge)
 	br.RegisterCommands()
+	for _, evtType := range groupAdminEventTypes {
+		br.EventProcessor.On(evtType, br.HandleMatrixGroupAdminEvent)
+	}
 
 	signalmeow.SetLogger(br.ZLog.With().Str("component", "signalmeow").Logger().Level(zerolog.DebugLevel))
 	//signalmeow.SetLogger(br.ZLog.With().Str("component", "signalmeow").Caller().Logger())
@@ -98,6 +101,7 @@ func (br *SignalBridge) Start() {
 		br.provisioning.Init()
 	}
 	go br.StartUsers()
+	go br.SleepAndDeleteUpcoming()
 }
 
 func (br *SignalBridge) Stop() {
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+
+	"maunium.net/go/mautrix"
+	"maunium.net/go/mautrix/appservice"
+	"maunium.net/go/mautrix/event"
+	"maunium.net/go/mautrix/id"
+
+	"go.mau.fi/mautrix-signal/pkg/signalmeow"
+)
+
+// Signal group admins get moderator rights in the portal room
+const signalAdminPowerLevel = 50
+
+// syncGroupMembership makes the Matrix room membership match the Signal group:
+// members are joined, pending members invited and banned members banned,
+// and ghosts that aren't in the group anymore leave.
+func (portal *Portal) syncGroupMembership(user *User, group *signalmeow.Group) {
+	if portal.MXID == "" || portal.IsPrivateChat() || portal.IsStories() {
+		return
+	}
+	if portal.lastSyncedRevision != 0 && portal.lastSyncedRevision == group.Revision {
+		return
+	}
+	portal.log.Debug().Msgf("Syncing membership of group %s at revision %d", group.GroupIdentifier, group.Revision)
+
+	desired := make(map[id.UserID]event.Membership)
+	for _, member := range group.Members {
+		// This includes the user's own ghost, which bridges messages sent from their phone if there's no double puppet
+		desired[portal.bridge.FormatPuppetMXID(member.UserId)] = event.MembershipJoin
+	}
+	for _, pendingMember := range group.PendingMembers {
+		if pendingMember.UserId == user.SignalID {
+			continue
+		}
+		// Invites can be for a PNI, which we can't tell apart from an ACI and which isn't a user we should have
+		// a ghost for, so only invite users we already know
+		if portal.bridge.DB.Puppet.GetBySignalID(pendingMember.UserId) == nil {
+			continue
+		}
+		desired[portal.bridge.FormatPuppetMXID(pendingMember.UserId)] = event.MembershipInvite
+	}
+	for _, bannedMember := range group.BannedMembers {
+		desired[portal.bridge.FormatPuppetMXID(bannedMember.UserId)] = event.MembershipBan
+	}
+
+	resp, err := portal.MainIntent().Members(portal.MXID)
+	if err != nil {
+		portal.log.Err(err).Msg("error getting room members")
+		return
+	}
+	current := make(map[id.UserID]event.Membership)
+	for _, evt := range resp.Chunk {
+		_ = evt.Content.ParseRaw(evt.Type)
+		current[id.UserID(evt.GetStateKey())] = evt.Content.AsMember().Membership
+	}
+
+	ok := true
+	for mxid, membership := range desired {
+		if current[mxid] == membership {
+			continue
+		}
+		puppet := portal.bridge.GetPuppetByMXID(mxid)
+		if puppet == nil {
+			continue
+		}
+		var err error
+		switch membership {
+		case event.MembershipJoin:
+			_ = updatePuppetWithSignalProfile(context.Background(), user, puppet)
+			err = puppet.DefaultIntent().EnsureJoined(portal.MXID)
+		case event.MembershipInvite:
+			if current[mxid] == event.MembershipJoin {
+				// Signal doesn't let members become pending again, so this is just us being out of date
+				continue
+			}
+			_ = updatePuppetWithSignalProfile(context.Background(), user, puppet)
+			_, err = portal.MainIntent().InviteUser(portal.MXID, &mautrix.ReqInviteUser{UserID: mxid})
+		case event.MembershipBan:
+			_, err = portal.MainIntent().BanUser(portal.MXID, &mautrix.ReqBanUser{UserID: mxid, Reason: "Banned from the Signal group"})
+		}
+		if err != nil {
+			portal.log.Err(err).Msgf("error setting membership of %s to %s", mxid, membership)
+			ok = false
+		}
+	}
+
+	for mxid, membership := range current {
+		if _, ok := desired[mxid]; ok {
+			continue
+		}
+		if _, isPuppet := portal.bridge.ParsePuppetMXID(mxid); !isPuppet {
+			continue
+		}
+		var err error
+		switch membership {
+		case event.MembershipJoin, event.MembershipInvite:
+			_, err = portal.bridge.AS.Intent(mxid).LeaveRoom(portal.MXID)
+		case event.MembershipBan:
+			_, err = portal.MainIntent().UnbanUser(portal.MXID, &mautrix.ReqUnbanUser{UserID: mxid})
+		default:
+			continue
+		}
+		if err != nil {
+			portal.log.Err(err).Msgf("error removing %s from room", mxid)
+			ok = false
+		}
+	}
+
+	if portal.syncGroupPowerLevels(user, group) && ok {
+		// Anything that failed is retried on the next sync
+		portal.lastSyncedRevision = group.Revision
+	}
+}
+
+// syncGroupPowerLevels maps Signal admins and the group's access control to Matrix power levels,
+// and returns whether the power levels are up to date.
+func (portal *Portal) syncGroupPowerLevels(user *User, group *signalmeow.Group) bool {
+	pl, err := portal.MainIntent().PowerLevels(portal.MXID)
+	if err != nil {
+		portal.log.Err(err).Msg("error getting power levels")
+		return false
+	}
+	changed := false
+
+	for _, member := range group.Members {
+		mxid := portal.bridge.FormatPuppetMXID(member.UserId)
+		if member.UserId == user.SignalID {
+			mxid = user.MXID
+		}
+		level := 0
+		if member.Role == signalmeow.GroupMember_ADMINISTRATOR {
+			level = signalAdminPowerLevel
+		}
+		if mxid != portal.MainIntent().UserID && pl.GetUserLevel(mxid) != level {
+			pl.SetUserLevel(mxid, level)
+			changed = true
+		}
+	}
+
+	attributesLevel := 0
+	membersLevel := 0
+	if group.AccessControl != nil {
+		if group.AccessControl.Attributes == signalmeow.AccessControl_ADMINISTRATOR {
+			attributesLevel = signalAdminPowerLevel
+		}
+		if group.AccessControl.Members == signalmeow.AccessControl_ADMINISTRATOR {
+			membersLevel = signalAdminPowerLevel
+		}
+	}
+	for _, evtType := range []event.Type{event.StateRoomName, event.StateRoomAvatar, event.StateTopic} {
+		if pl.GetEventLevel(evtType) != attributesLevel {
+			pl.SetEventLevel(evtType, attributesLevel)
+			changed = true
+		}
+	}
+	if pl.Invite() != membersLevel {
+		pl.InvitePtr = &membersLevel
+		changed = true
+	}
+	// Only admins can remove members on Signal
+	adminLevel := signalAdminPowerLevel
+	if pl.Kick() != adminLevel {
+		pl.KickPtr = &adminLevel
+		changed = true
+	}
+	if pl.Ban() != adminLevel {
+		pl.BanPtr = &adminLevel
+		changed = true
+	}
+
+	if changed {
+		_, err = portal.MainIntent().SetPowerLevels(portal.MXID, pl)
+		if err != nil {
+			portal.log.Err(err).Msg("error setting power levels")
+			return false
+		}
+	}
+	return true
+}
+
+// withFallbackIntent runs a membership action as the given intent (usually whoever made the change on Signal),
+// and retries it as the portal's main intent if that isn't allowed.
+func (portal *Portal) withFallbackIntent(intent *appservice.IntentAPI, action func(intent *appservice.IntentAPI) error) error {
+	err := action(intent)
+	if err != nil && intent != portal.MainIntent() {
+		portal.log.Debug().Err(err).Msgf("Membership action as %s failed, retrying as main intent", intent.UserID)
+		err = action(portal.MainIntent())
+	}
+	return err
+}
@@ -26,6 +26,7 @@ var (
 	errMediaConvertFailed          = errors.New("failed to convert media")
 	errMediaWhatsAppUploadFailed   = errors.New("failed to upload media to Signal")
 	errMediaUnsupportedType        = errors.New("unsupported media type")
+	errMediaTooLarge               = errors.New("media is too large")
 	errTargetNotFound              = errors.New("target event not found")
 	errReactionDatabaseNotFound    = errors.New("reaction database entry not found")
 	errReactionTargetNotFound      = errors.New("reaction target message not found")
@@ -40,8 +41,8 @@ var (
 	errEditDifferentSender   = errors.New("can't edit message sent by another user")
 	errEditTooOld            = errors.New("message is too old to be edited")
 
-	errBroadcastReactionNotSupported = errors.New("reacting to status messages is not currently supported")
-	errBroadcastSendDisabled         = errors.New("sending status messages is disabled")
+	errBroadcastReactionNotSupported = errors.New("reacting to stories is not currently supported")
+	errBroadcastSendDisabled         = errors.New("posting stories is not supported")
 
 	errMessageTakingLong     = errors.New("bridging the message is taking longer than usual")
 	errTimeoutBeforeHandling = errors.New("message timed out before handling was started")
@@ -58,6 +59,7 @@ func errorToStatusReason(err error) (reason event.MessageStatusReason, status ev
 	case errors.Is(err, errMNoticeDisabled):
 		return event.MessageStatusUnsupported, event.MessageStatusFail, true, false, ""
 	case errors.Is(err, errMediaUnsupportedType),
+		errors.Is(err, errMediaTooLarge),
 		errors.Is(err, errPollMissingQuestion),
 		errors.Is(err, errPollDuplicateOption),
 		errors.Is(err, errEditDifferentSender),
@@ -205,7 +207,9 @@ func (portal *Portal) sendMessageMetrics(evt *event.Event, err error, part strin
 		portal.sendDeliveryReceipt(evt.ID)
 		portal.bridge.SendMessageSuccessCheckpoint(evt, status.MsgStepRemote, ms.getRetryNum())
 		var deliveredTo *[]id.UserID
-		if portal.IsPrivateChat() {
+		// An empty list means delivery will be reported as the receipts come in,
+		// which is done for messages in groups too, since the receipts are tracked per recipient
+		if portal.IsPrivateChat() || evt.Type == event.EventMessage || evt.Type == event.EventSticker {
 			deliveredTo = &[]id.UserID{}
 		}
 		portal.sendStatusEvent(origEvtID, evt.ID, nil, deliveredTo)
@@ -94,5 +94,9 @@ func (dem *DecryptionErrorMessage) GetRatchetKey() (*PublicKey, error) {
 	if signalFfiError != nil {
 		return nil, wrapError(signalFfiError)
 	}
+	if pk == nil {
+		// Errors for sender key messages don't have a ratchet key
+		return nil, nil
+	}
 	return wrapPublicKey(pk), nil
 }
@@ -56,6 +56,17 @@ func DeriveGroupSecretParamsFromMasterKey(groupMasterKey GroupMasterKey) (GroupS
 	return groupSecretParams, nil
 }
 
+func (gsp *GroupSecretParams) GetMasterKey() (*GroupMasterKey, error) {
+	var masterKey [C.SignalGROUP_MASTER_KEY_LEN]C.uchar
+	signalFfiError := C.signal_group_secret_params_get_master_key(&masterKey, (*[C.SignalGROUP_SECRET_PARAMS_LEN]C.uint8_t)(unsafe.Pointer(gsp)))
+	if signalFfiError != nil {
+		return nil, wrapError(signalFfiError)
+	}
+	var groupMasterKey GroupMasterKey
+	copy(groupMasterKey[:], C.GoBytes(unsafe.Pointer(&masterKey), C.int(C.SignalGROUP_MASTER_KEY_LEN)))
+	return &groupMasterKey, nil
+}
+
 func (gsp *GroupSecretParams) GetPublicParams() (*GroupPublicParams, error) {
 	var publicParams [C.SignalGROUP_PUBLIC_PARAMS_LEN]C.uchar
 	signalFfiError := C.signal_group_secret_params_get_public_params(&publicParams, (*[C.SignalGROUP_SECRET_PARAMS_LEN]C.uint8_t)(unsafe.Pointer(gsp)))
@@ -92,6 +103,37 @@ func (gsp *GroupSecretParams) DecryptBlobWithPadding(blob []byte) ([]byte, error
 	return CopySignalOwnedBufferToBytes(plaintext), nil
 }
 
+func (gsp *GroupSecretParams) EncryptBlobWithPadding(randomness Randomness, plaintext []byte, paddingLen uint32) ([]byte, error) {
+	var ciphertext C.SignalOwnedBuffer = C.SignalOwnedBuffer{}
+	borrowedPlaintext := BytesToBuffer(plaintext)
+	signalFfiError := C.signal_group_secret_params_encrypt_blob_with_padding_deterministic(
+		&ciphertext,
+		(*[C.SignalGROUP_SECRET_PARAMS_LEN]C.uint8_t)(unsafe.Pointer(gsp)),
+		(*[C.SignalRANDOMNESS_LEN]C.uint8_t)(unsafe.Pointer(&randomness)),
+		borrowedPlaintext,
+		C.uint32_t(paddingLen),
+	)
+	if signalFfiError != nil {
+		return nil, wrapError(signalFfiError)
+	}
+	return CopySignalOwnedBufferToBytes(ciphertext), nil
+}
+
+func (gsp *GroupSecretParams) EncryptUUID(uuid UUID) (*UUIDCiphertext, error) {
+	ciphertext := [C.SignalUUID_CIPHERTEXT_LEN]C.uchar{}
+	signalFfiError := C.signal_group_secret_params_encrypt_uuid(
+		&ciphertext,
+		(*[C.SignalGROUP_SECRET_PARAMS_LEN]C.uint8_t)(unsafe.Pointer(gsp)),
+		(*[C.SignalUUID_LEN]C.uint8_t)(unsafe.Pointer(&uuid)),
+	)
+	if signalFfiError != nil {
+		return nil, wrapError(signalFfiError)
+	}
+	var result UUIDCiphertext
+	copy(result[:], C.GoBytes(unsafe.Pointer(&ciphertext), C.int(C.SignalUUID_CIPHERTEXT_LEN)))
+	return &result, nil
+}
+
 func (gsp *GroupSecretParams) DecryptUUID(ciphertextUUID UUIDCiphertext) (*UUID, error) {
 	uuid := [C.SignalUUID_LEN]C.uchar{}
 	signalFfiError := C.signal_group_secret_params_decrypt_uuid(
@@ -111,6 +111,23 @@ type ProfileKeyCredentialPresentation []byte
 type ServerPublicParams [C.SignalSERVER_PUBLIC_PARAMS_LEN]byte
 type UUID [C.SignalUUID_LEN]byte
 
+func (p ServerPublicParams) VerifySignature(message []byte, signature []byte) error {
+	if len(signature) != C.SignalSIGNATURE_LEN {
+		return fmt.Errorf("invalid signature length %d", len(signature))
+	}
+	c_serverPublicParams := (*[C.SignalSERVER_PUBLIC_PARAMS_LEN]C.uchar)(unsafe.Pointer(&p[0]))
+	c_signature := (*[C.SignalSIGNATURE_LEN]C.uchar)(unsafe.Pointer(&signature[0]))
+	signalFfiError := C.signal_server_public_params_verify_signature(
+		c_serverPublicParams,
+		BytesToBuffer(message),
+		c_signature,
+	)
+	if signalFfiError != nil {
+		return wrapError(signalFfiError)
+	}
+	return nil
+}
+
 func CreateProfileKeyCredentialRequestContext(serverPublicParams ServerPublicParams, uuid UUID, profileKey ProfileKey) (*ProfileKeyCredentialRequestContext, error) {
 	c_result := [C.SignalPROFILE_KEY_CREDENTIAL_REQUEST_CONTEXT_LEN]C.uchar{}
 	c_serverPublicParams := (*[C.SignalSERVER_PUBLIC_PARAMS_LEN]C.uchar)(unsafe.Pointer(&serverPublicParams[0]))
@@ -157,6 +174,73 @@ func NewProfileKeyCredentialResponse(b []byte) (ProfileKeyCredentialResponse, er
 	return ProfileKeyCredentialResponse(b), nil
 }
 
+type ExpiringProfileKeyCredential [C.SignalEXPIRING_PROFILE_KEY_CREDENTIAL_LEN]byte
+
+func ReceiveExpiringProfileKeyCredential(
+	serverPublicParams ServerPublicParams,
+	requestContext *ProfileKeyCredentialRequestContext,
+	response ProfileKeyCredentialResponse,
+	currentTimeInSeconds uint64,
+) (*ExpiringProfileKeyCredential, error) {
+	if len(response) != C.SignalEXPIRING_PROFILE_KEY_CREDENTIAL_RESPONSE_LEN {
+		return nil, fmt.Errorf("invalid expiring profile key credential response length %d", len(response))
+	}
+	c_result := [C.SignalEXPIRING_PROFILE_KEY_CREDENTIAL_LEN]C.uchar{}
+	c_serverPublicParams := (*[C.SignalSERVER_PUBLIC_PARAMS_LEN]C.uchar)(unsafe.Pointer(&serverPublicParams[0]))
+	c_requestContext := (*[C.SignalPROFILE_KEY_CREDENTIAL_REQUEST_CONTEXT_LEN]C.uchar)(unsafe.Pointer(requestContext))
+	c_response := (*[C.SignalEXPIRING_PROFILE_KEY_CREDENTIAL_RESPONSE_LEN]C.uchar)(unsafe.Pointer(&response[0]))
+
+	signalFfiError := C.signal_server_public_params_receive_expiring_profile_key_credential(
+		&c_result,
+		c_serverPublicParams,
+		c_requestContext,
+		c_response,
+		C.uint64_t(currentTimeInSeconds),
+	)
+	if signalFfiError != nil {
+		return nil, wrapError(signalFfiError)
+	}
+	result := ExpiringProfileKeyCredential(C.GoBytes(unsafe.Pointer(&c_result), C.int(C.SignalEXPIRING_PROFILE_KEY_CREDENTIAL_LEN)))
+	return &result, nil
+}
+
+func (c *ExpiringProfileKeyCredential) ExpirationTime() (uint64, error) {
+	var expiration C.uint64_t
+	signalFfiError := C.signal_expiring_profile_key_credential_get_expiration_time(
+		&expiration,
+		(*[C.SignalEXPIRING_PROFILE_KEY_CREDENTIAL_LEN]C.uchar)(unsafe.Pointer(c)),
+	)
+	if signalFfiError != nil {
+		return 0, wrapError(signalFfiError)
+	}
+	return uint64(expiration), nil
+}
+
+func CreateExpiringProfileKeyCredentialPresentation(
+	serverPublicParams ServerPublicParams,
+	randomness Randomness,
+	groupSecretParams GroupSecretParams,
+	credential ExpiringProfileKeyCredential,
+) (ProfileKeyCredentialPresentation, error) {
+	var c_result C.SignalOwnedBuffer = C.SignalOwnedBuffer{}
+	c_serverPublicParams := (*[C.SignalSERVER_PUBLIC_PARAMS_LEN]C.uchar)(unsafe.Pointer(&serverPublicParams[0]))
+	c_randomness := (*[C.SignalRANDOMNESS_LEN]C.uchar)(unsafe.Pointer(&randomness[0]))
+	c_groupSecretParams := (*[C.SignalGROUP_SECRET_PARAMS_LEN]C.uchar)(unsafe.Pointer(&groupSecretParams[0]))
+	c_credential := (*[C.SignalEXPIRING_PROFILE_KEY_CREDENTIAL_LEN]C.uchar)(unsafe.Pointer(&credential[0]))
+
+	signalFfiError := C.signal_server_public_params_create_expiring_profile_key_credential_presentation_deterministic(
+		&c_result,
+		c_serverPublicParams,
+		c_randomness,
+		c_groupSecretParams,
+		c_credential,
+	)
+	if signalFfiError != nil {
+		return nil, wrapError(signalFfiError)
+	}
+	return ProfileKeyCredentialPresentation(CopySignalOwnedBufferToBytes(c_result)), nil
+}
+
 //func NewProfileKeyCredentialPresentation(b []byte) (ProfileKeyCredentialPresentation, error) {
 //	C.signal_profile_key_credential_presentation_check_valid_contents(cBytes(b), cLen(b))
 //	if res := C.FFI_ProfileKeyCredentialPresentation_checkValidContents(cBytes(b), cLen(b)); res != C.FFI_RETURN_OK {
@@ -6,8 +6,10 @@ package libsignalgo
 */
 import "C"
 import (
+	"errors"
 	"runtime"
 	"time"
+	"unsafe"
 
 	"github.com/google/uuid"
 	gopointer "github.com/mattn/go-pointer"
@@ -67,7 +69,47 @@ func SealedSenderMultiRecipientEncrypt(messageContent *UnidentifiedSenderMessage
 	contextPointer := gopointer.Save(ctx)
 	defer gopointer.Unref(contextPointer)
 
-	panic("not implemented")
+	if len(forRecipients) == 0 {
+		return nil, errors.New("no recipients for multi-recipient message")
+	}
+
+	// libsignal wants the session for every recipient up front, in the same order as the addresses
+	addressPtrs := make([]*C.SignalProtocolAddress, len(forRecipients))
+	sessionPtrs := make([]*C.SignalSessionRecord, len(forRecipients))
+	sessions := make([]*SessionRecord, len(forRecipients))
+	for i, address := range forRecipients {
+		session, err := sessionStore.LoadSession(address, ctx.Ctx)
+		if err != nil {
+			return nil, err
+		} else if session == nil {
+			return nil, errors.New("missing session for multi-recipient message recipient")
+		}
+		addressPtrs[i] = address.ptr
+		sessionPtrs[i] = session.ptr
+		sessions[i] = session
+	}
+
+	var encrypted C.SignalOwnedBuffer = C.SignalOwnedBuffer{}
+	signalFfiError := C.signal_sealed_sender_multi_recipient_encrypt(
+		&encrypted,
+		C.SignalBorrowedSliceOfProtocolAddress{
+			base:   (**C.SignalProtocolAddress)(unsafe.Pointer(&addressPtrs[0])),
+			length: C.uintptr_t(len(addressPtrs)),
+		},
+		C.SignalBorrowedSliceOfSessionRecord{
+			base:   (**C.SignalSessionRecord)(unsafe.Pointer(&sessionPtrs[0])),
+			length: C.uintptr_t(len(sessionPtrs)),
+		},
+		messageContent.ptr,
+		wrapIdentityKeyStore(identityStore),
+		contextPointer,
+	)
+	runtime.KeepAlive(forRecipients)
+	runtime.KeepAlive(sessions)
+	if signalFfiError != nil {
+		return nil, wrapCallbackError(signalFfiError, ctx)
+	}
+	return CopySignalOwnedBufferToBytes(encrypted), nil
 }
 
 type SealedSenderResult struct {
@@ -0,0 +1,79 @@
+package signalfmt
+
+import (
+	"strings"
+
+	"maunium.net/go/mautrix/format"
+)
+
+// Styled text is wrapped in markers from the supplementary private use area while parsing HTML,
+// so the body ranges can be found after the HTML parser has flattened everything into text.
+const (
+	openMarkerBase  = 0xF0000
+	closeMarkerBase = 0xF0100
+)
+
+func wrapInMarkers(text string, style Style) string {
+	return string(rune(openMarkerBase+int(style))) + text + string(rune(closeMarkerBase+int(style)))
+}
+
+func styleConverter(style Style) format.TextConverter {
+	return func(text string, _ format.Context) string {
+		return wrapInMarkers(text, style)
+	}
+}
+
+// AddStyleConverters makes an HTML parser mark the text of formatting tags that Signal supports,
+// use ExtractStyles on the parsed text to turn the marks into body ranges.
+func AddStyleConverters(parser *format.HTMLParser) {
+	parser.BoldConverter = styleConverter(StyleBold)
+	parser.ItalicConverter = styleConverter(StyleItalic)
+	parser.StrikethroughConverter = styleConverter(StyleStrikethrough)
+	parser.MonospaceConverter = styleConverter(StyleMonospace)
+	parser.MonospaceBlockConverter = func(code, _ string, _ format.Context) string {
+		return wrapInMarkers(strings.TrimSuffix(code, "\n"), StyleMonospace)
+	}
+	parser.SpoilerConverter = func(text, _ string, _ format.Context) string {
+		return wrapInMarkers(text, StyleSpoiler)
+	}
+}
+
+// ExtractStyles removes the marks added by the converters of AddStyleConverters from text,
+// and returns the text with the body ranges they marked.
+func ExtractStyles(text string) (string, []BodyRange) {
+	var output strings.Builder
+	var ranges, openRanges []BodyRange
+	offset := 0
+	for _, c := range text {
+		switch {
+		case c > openMarkerBase && c <= openMarkerBase+rune(StyleMonospace):
+			openRanges = append(openRanges, BodyRange{Start: offset, Style: Style(c - openMarkerBase)})
+		case c > closeMarkerBase && c <= closeMarkerBase+rune(StyleMonospace):
+			style := Style(c - closeMarkerBase)
+			for i := len(openRanges) - 1; i >= 0; i-- {
+				if openRanges[i].Style != style {
+					continue
+				}
+				br := openRanges[i]
+				openRanges = append(openRanges[:i], openRanges[i+1:]...)
+				br.Length = offset - br.Start
+				if br.Length > 0 {
+					ranges = append(ranges, br)
+				}
+				break
+			}
+		default:
+			output.WriteRune(c)
+			offset += UTF16Length(c)
+		}
+	}
+	return output.String(), ranges
+}
+
+// UTF16Length returns how many UTF-16 code units are needed to encode a rune, which is what Signal uses for offsets
+func UTF16Length(c rune) int {
+	if c >= 0x10000 {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,201 @@
+// Package signalfmt converts between the body ranges of Signal messages and Matrix HTML.
+package signalfmt
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"maunium.net/go/mautrix/id"
+)
+
+// Style is the formatting of a body range, the values match BodyRange.Style in the Signal protobufs
+type Style int
+
+const (
+	StyleNone Style = iota
+	StyleBold
+	StyleItalic
+	StyleSpoiler
+	StyleStrikethrough
+	StyleMonospace
+)
+
+// BodyRange is a styled or mentioned part of a message. Start and Length are in UTF-16 code units,
+// like on Signal. Mentions have a MentionMXID instead of a Style and replace the text they cover.
+type BodyRange struct {
+	Start  int
+	Length int
+	Style  Style
+
+	MentionMXID id.UserID
+	MentionName string
+}
+
+func (br BodyRange) end() int {
+	return br.Start + br.Length
+}
+
+func (br BodyRange) isMention() bool {
+	return br.MentionMXID != ""
+}
+
+// ToMatrix applies body ranges to the text of a Signal message and returns the plain text body
+// (with mentions replaced by names) and the HTML body for Matrix. Ranges may be nested and overlap,
+// overlapping ones are split into properly nested HTML tags.
+func ToMatrix(text string, ranges []BodyRange) (string, string) {
+	utf16Text := utf16.Encode([]rune(text))
+	var styles, mentions []BodyRange
+	for _, br := range ranges {
+		if br.Start < 0 || br.Length <= 0 || br.Start >= len(utf16Text) {
+			continue
+		}
+		if br.end() > len(utf16Text) {
+			br.Length = len(utf16Text) - br.Start
+		}
+		if br.isMention() {
+			mentions = append(mentions, br)
+		} else if br.Style > StyleNone && br.Style <= StyleMonospace {
+			styles = append(styles, br)
+		}
+	}
+	// Outer ranges first, so they're opened before the ranges they contain
+	sort.SliceStable(styles, func(i, j int) bool {
+		if styles[i].Start != styles[j].Start {
+			return styles[i].Start < styles[j].Start
+		}
+		return styles[i].Length > styles[j].Length
+	})
+	sort.SliceStable(mentions, func(i, j int) bool {
+		return mentions[i].Start < mentions[j].Start
+	})
+
+	boundarySet := map[int]struct{}{0: {}, len(utf16Text): {}}
+	for _, br := range append(styles, mentions...) {
+		boundarySet[br.Start] = struct{}{}
+		boundarySet[br.end()] = struct{}{}
+	}
+	boundaries := make([]int, 0, len(boundarySet))
+	for boundary := range boundarySet {
+		boundaries = append(boundaries, boundary)
+	}
+	sort.Ints(boundaries)
+
+	var body, formatted strings.Builder
+	var open []int
+	closeTags := func(keep int) {
+		for i := len(open) - 1; i >= keep; i-- {
+			formatted.WriteString(closeTag(styles[open[i]], utf16Text))
+		}
+		open = open[:keep]
+	}
+	writtenUntil := 0
+	nextMention := 0
+	for i := 0; i+1 < len(boundaries); i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		if start < writtenUntil {
+			// Inside a mention that was already written
+			continue
+		}
+		// Ranges that cover this segment, keeping the ones that are still open and opening the rest
+		var active []int
+		for j, style := range styles {
+			if style.Start <= start && style.end() >= end {
+				active = append(active, j)
+			}
+		}
+		keep := 0
+		for keep < len(open) && keep < len(active) && open[keep] == active[keep] {
+			keep++
+		}
+		closeTags(keep)
+		for _, j := range active[keep:] {
+			formatted.WriteString(openTag(styles[j], utf16Text))
+			open = append(open, j)
+		}
+
+		for nextMention < len(mentions) && mentions[nextMention].Start < start {
+			nextMention++
+		}
+		if nextMention < len(mentions) && mentions[nextMention].Start == start {
+			mention := mentions[nextMention]
+			body.WriteString(mention.MentionName)
+			formatted.WriteString(fmt.Sprintf(`<a href="https://matrix.to/#/%s">%s</a>`, mention.MentionMXID, html.EscapeString(mention.MentionName)))
+			writtenUntil = mention.end()
+			nextMention++
+			continue
+		}
+		segment := string(utf16.Decode(utf16Text[start:end]))
+		body.WriteString(segment)
+		segment = html.EscapeString(segment)
+		if !inCodeBlock(open, styles, utf16Text) {
+			segment = strings.ReplaceAll(segment, "\n", "<br>")
+		}
+		formatted.WriteString(segment)
+		writtenUntil = end
+	}
+	closeTags(0)
+	return body.String(), formatted.String()
+}
+
+// isCodeBlock checks if a monospace range spans multiple lines, which makes it a code block instead of inline code
+func isCodeBlock(br BodyRange, utf16Text []uint16) bool {
+	if br.Style != StyleMonospace {
+		return false
+	}
+	for _, c := range utf16Text[br.Start:br.end()] {
+		if c == '\n' {
+			return true
+		}
+	}
+	return false
+}
+
+func inCodeBlock(open []int, styles []BodyRange, utf16Text []uint16) bool {
+	for _, j := range open {
+		if isCodeBlock(styles[j], utf16Text) {
+			return true
+		}
+	}
+	return false
+}
+
+func openTag(br BodyRange, utf16Text []uint16) string {
+	switch br.Style {
+	case StyleBold:
+		return "<strong>"
+	case StyleItalic:
+		return "<em>"
+	case StyleSpoiler:
+		return "<span data-mx-spoiler>"
+	case StyleStrikethrough:
+		return "<del>"
+	case StyleMonospace:
+		if isCodeBlock(br, utf16Text) {
+			return "<pre><code>"
+		}
+		return "<code>"
+	}
+	return ""
+}
+
+func closeTag(br BodyRange, utf16Text []uint16) string {
+	switch br.Style {
+	case StyleBold:
+		return "</strong>"
+	case StyleItalic:
+		return "</em>"
+	case StyleSpoiler:
+		return "</span>"
+	case StyleStrikethrough:
+		return "</del>"
+	case StyleMonospace:
+		if isCodeBlock(br, utf16Text) {
+			return "</code></pre>"
+		}
+		return "</code>"
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+package signalfmt
+
+import (
+	"reflect"
+	"testing"
+
+	"maunium.net/go/mautrix/format"
+)
+
+func TestToMatrix(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		ranges []BodyRange
+		body   string
+		html   string
+	}{{
+		name: "plain",
+		text: "hello <world>",
+		body: "hello <world>",
+		html: "hello &lt;world&gt;",
+	}, {
+		name:   "bold",
+		text:   "hello world",
+		ranges: []BodyRange{{Start: 6, Length: 5, Style: StyleBold}},
+		body:   "hello world",
+		html:   "hello <strong>world</strong>",
+	}, {
+		name: "nested",
+		text: "hello world",
+		ranges: []BodyRange{
+			{Start: 6, Length: 2, Style: StyleItalic},
+			{Start: 0, Length: 11, Style: StyleBold},
+		},
+		body: "hello world",
+		html: "<strong>hello <em>wo</em>rld</strong>",
+	}, {
+		name: "overlapping",
+		text: "abcdef",
+		ranges: []BodyRange{
+			{Start: 0, Length: 4, Style: StyleBold},
+			{Start: 2, Length: 4, Style: StyleStrikethrough},
+		},
+		body: "abcdef",
+		html: "<strong>ab<del>cd</del></strong><del>ef</del>",
+	}, {
+		name:   "utf-16",
+		text:   "🐈 cat",
+		ranges: []BodyRange{{Start: 3, Length: 3, Style: StyleSpoiler}},
+		body:   "🐈 cat",
+		html:   "🐈 <span data-mx-spoiler>cat</span>",
+	}, {
+		name:   "code block",
+		text:   "code:\nif a < b {\n}",
+		ranges: []BodyRange{{Start: 6, Length: 12, Style: StyleMonospace}},
+		body:   "code:\nif a < b {\n}",
+		html:   "code:<br><pre><code>if a &lt; b {\n}</code></pre>",
+	}, {
+		name: "mention",
+		text: "hi \uFFFC!",
+		ranges: []BodyRange{
+			{Start: 3, Length: 1, MentionMXID: "@signal_1:example.com", MentionName: "Alice"},
+			{Start: 0, Length: 5, Style: StyleItalic},
+		},
+		body: "hi Alice!",
+		html: `<em>hi <a href="https://matrix.to/#/@signal_1:example.com">Alice</a>!</em>`,
+	}, {
+		name:   "out of bounds",
+		text:   "abc",
+		ranges: []BodyRange{{Start: 1, Length: 10, Style: StyleBold}, {Start: 5, Length: 1, Style: StyleBold}},
+		body:   "abc",
+		html:   "a<strong>bc</strong>",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, html := ToMatrix(test.text, test.ranges)
+			if body != test.body {
+				t.Errorf("expected body %q, got %q", test.body, body)
+			}
+			if html != test.html {
+				t.Errorf("expected HTML %q, got %q", test.html, html)
+			}
+		})
+	}
+}
+
+func TestExtractStyles(t *testing.T) {
+	tests := []struct {
+		name   string
+		html   string
+		text   string
+		ranges []BodyRange
+	}{{
+		name: "nested",
+		html: "<strong>hello <em>wo</em>rld</strong>",
+		text: "hello world",
+		ranges: []BodyRange{
+			{Start: 6, Length: 2, Style: StyleItalic},
+			{Start: 0, Length: 11, Style: StyleBold},
+		},
+	}, {
+		name:   "utf-16",
+		html:   "🐈 <span data-mx-spoiler>cat</span>",
+		text:   "🐈 cat",
+		ranges: []BodyRange{{Start: 3, Length: 3, Style: StyleSpoiler}},
+	}, {
+		name:   "code block",
+		html:   "<pre><code class=\"language-go\">x := 1\n</code></pre>",
+		text:   "x := 1",
+		ranges: []BodyRange{{Start: 0, Length: 6, Style: StyleMonospace}},
+	}, {
+		name: "mention",
+		html: `<del><a href="https://matrix.to/#/@alice:example.com">Alice</a></del> <code>hi</code>`,
+		text: "\uFFFC hi",
+		ranges: []BodyRange{
+			{Start: 0, Length: 1, Style: StyleStrikethrough},
+			{Start: 2, Length: 2, Style: StyleMonospace},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parser := &format.HTMLParser{
+				TabsToSpaces: 4,
+				Newline:      "\n",
+				PillConverter: func(displayname, mxid, eventID string, ctx format.Context) string {
+					return "\uFFFC"
+				},
+			}
+			AddStyleConverters(parser)
+			text, ranges := ExtractStyles(parser.Parse(test.html, format.NewContext()))
+			if text != test.text {
+				t.Errorf("expected text %q, got %q", test.text, text)
+			}
+			if !reflect.DeepEqual(ranges, test.ranges) {
+				t.Errorf("expected ranges %+v, got %+v", test.ranges, ranges)
+			}
+		})
+	}
+}
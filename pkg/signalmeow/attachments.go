@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"os"
 
 	"github.com/rs/zerolog/log"
 	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
@@ -37,46 +38,285 @@ func getAttachmentPath(id uint64, key string, cdnNumber uint32) (string, error)
 	return fmt.Sprintf(attachmentKeyDownloadPath, key), nil
 }
 
-// ErrInvalidMACForAttachment signals that the downloaded attachment has an invalid MAC.
-var ErrInvalidMACForAttachment = errors.New("invalid MAC for attachment")
+// DefaultMaxAttachmentSize is the size limit for attachments that signalmeow downloads by itself, like stickers and contact lists
+const DefaultMaxAttachmentSize = 100 * 1024 * 1024
+
+var (
+	// ErrInvalidMACForAttachment signals that the downloaded attachment has an invalid MAC.
+	ErrInvalidMACForAttachment = errors.New("invalid MAC for attachment")
+	// ErrInvalidDigestForAttachment signals that the downloaded attachment doesn't match the digest in its pointer.
+	ErrInvalidDigestForAttachment = errors.New("invalid digest for attachment")
+	// ErrAttachmentTooLarge signals that an attachment is larger than the allowed maximum size.
+	ErrAttachmentTooLarge = errors.New("attachment too large")
+)
+
+// paddedAttachmentSize returns the size an attachment is padded to before encryption. Padded length uses exponential bracketing.
+func paddedAttachmentSize(size int64) int64 {
+	paddedLen := int64(math.Max(541, math.Floor(math.Pow(1.05, math.Ceil(math.Log(float64(size))/math.Log(1.05))))))
+	if paddedLen < size {
+		return size
+	}
+	return paddedLen
+}
+
+// encryptedAttachmentSize returns the size of an encrypted attachment: IV, padded and PKCS#7 padded ciphertext and MAC
+func encryptedAttachmentSize(size int64) int64 {
+	return aes.BlockSize + (paddedAttachmentSize(size)/aes.BlockSize+1)*aes.BlockSize + sha256.Size
+}
 
 func fetchAndDecryptAttachment(a *signalpb.AttachmentPointer) ([]byte, error) {
-	path, err := getAttachmentPath(a.GetCdnId(), a.GetCdnKey(), a.GetCdnNumber())
+	var buf bytes.Buffer
+	err := downloadAttachment(a, &buf, DefaultMaxAttachmentSize)
 	if err != nil {
 		return nil, err
 	}
+	return buf.Bytes(), nil
+}
+
+// DownloadAttachment downloads an attachment, checks its digest and MAC and writes the decrypted attachment to w.
+// The encrypted attachment is streamed through a temp file, so it never has to fit in memory.
+// Attachments larger than maxSize bytes are rejected with ErrAttachmentTooLarge.
+func DownloadAttachment(a *AttachmentPointer, w io.Writer, maxSize int64) error {
+	return downloadAttachment((*signalpb.AttachmentPointer)(a), w, maxSize)
+}
+
+func downloadAttachment(a *signalpb.AttachmentPointer, w io.Writer, maxSize int64) error {
+	if len(a.GetKey()) != 64 {
+		return fmt.Errorf("invalid attachment key length %d", len(a.GetKey()))
+	}
+	if int64(a.GetSize()) > maxSize {
+		return fmt.Errorf("%w (%d > %d bytes)", ErrAttachmentTooLarge, a.GetSize(), maxSize)
+	}
+	path, err := getAttachmentPath(a.GetCdnId(), a.GetCdnKey(), a.GetCdnNumber())
+	if err != nil {
+		return err
+	}
 	resp, err := web.GetAttachment(path, a.GetCdnNumber(), nil)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d downloading attachment", resp.StatusCode)
+	}
+	maxEncryptedSize := encryptedAttachmentSize(maxSize)
+	if resp.ContentLength > maxEncryptedSize {
+		return fmt.Errorf("%w (%d > %d encrypted bytes)", ErrAttachmentTooLarge, resp.ContentLength, maxEncryptedSize)
 	}
-	bodyReader := resp.Body
-	defer bodyReader.Close()
+	return verifyAndDecryptAttachment(resp.Body, a, w, maxSize)
+}
 
-	body, err := io.ReadAll(bodyReader)
+// verifyAndDecryptAttachment reads an encrypted attachment from r, checks its digest and MAC and writes the
+// decrypted attachment to w
+func verifyAndDecryptAttachment(r io.Reader, a *signalpb.AttachmentPointer, w io.Writer, maxSize int64) error {
+	maxEncryptedSize := encryptedAttachmentSize(maxSize)
+	tempFile, err := os.CreateTemp("", "signalmeow-attachment-*")
 	if err != nil {
-		return nil, err
+		return err
+	}
+	defer func() {
+		_ = tempFile.Close()
+		_ = os.Remove(tempFile.Name())
+	}()
+
+	// The digest covers the whole encrypted attachment, MAC included
+	digest := sha256.New()
+	encryptedSize, err := io.Copy(io.MultiWriter(tempFile, digest), io.LimitReader(r, maxEncryptedSize+1))
+	if err != nil {
+		return err
+	} else if encryptedSize > maxEncryptedSize {
+		return fmt.Errorf("%w (more than %d encrypted bytes)", ErrAttachmentTooLarge, maxEncryptedSize)
+	} else if encryptedSize < 2*aes.BlockSize+sha256.Size {
+		return fmt.Errorf("encrypted attachment too short (%d bytes)", encryptedSize)
+	}
+	if len(a.GetDigest()) > 0 && !hmac.Equal(digest.Sum(nil), a.GetDigest()) {
+		return ErrInvalidDigestForAttachment
 	}
 
-	return decryptAttachment(body, a.Key, *a.Size)
+	// Check the MAC before decrypting anything
+	ciphertextSize := encryptedSize - sha256.Size
+	if _, err = tempFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	mac := hmac.New(sha256.New, a.Key[32:])
+	if _, err = io.CopyN(mac, tempFile, ciphertextSize); err != nil {
+		return err
+	}
+	expectedMAC := make([]byte, sha256.Size)
+	if _, err = io.ReadFull(tempFile, expectedMAC); err != nil {
+		return err
+	}
+	if !hmac.Equal(mac.Sum(nil), expectedMAC) {
+		return ErrInvalidMACForAttachment
+	}
+
+	if _, err = tempFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	plaintextSize := int64(-1)
+	if a.Size != nil {
+		plaintextSize = int64(a.GetSize())
+	}
+	return decryptAttachment(io.LimitReader(tempFile, ciphertextSize), w, a.Key[:32], plaintextSize)
 }
 
-func decryptAttachment(body, key []byte, size uint32) ([]byte, error) {
-	l := len(body) - 32
-	if !verifyMAC(key[32:], body[:l], body[l:]) {
-		return nil, ErrInvalidMACForAttachment
+// decryptAttachment decrypts an IV and AES-CBC ciphertext from r into w. Unless size is negative, the plaintext
+// is truncated to it, which removes the padding added before encryption.
+func decryptAttachment(r io.Reader, w io.Writer, key []byte, size int64) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err = io.ReadFull(r, iv); err != nil {
+		return err
+	}
+	mode := cipher.NewCBCDecrypter(block, iv)
+	out := &truncatingWriter{w: w, remaining: size}
+	if size < 0 {
+		out.remaining = math.MaxInt64
 	}
 
-	// TODO: verify digest?
-	decrypted, err := aesDecrypt(key[:32], body[:l])
+	// The last block is held back until the end, so its PKCS#7 padding can be removed
+	buf := make([]byte, 32*1024)
+	var lastBlock []byte
+	for {
+		n, readErr := io.ReadFull(r, buf)
+		if n%aes.BlockSize != 0 {
+			return errors.New("ciphertext not multiple of AES blocksize")
+		}
+		if n > 0 {
+			mode.CryptBlocks(buf[:n], buf[:n])
+			if _, err = out.Write(lastBlock); err != nil {
+				return err
+			}
+			if _, err = out.Write(buf[:n-aes.BlockSize]); err != nil {
+				return err
+			}
+			lastBlock = append(lastBlock[:0], buf[n-aes.BlockSize:n]...)
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		} else if readErr != nil {
+			return readErr
+		}
+	}
+	if lastBlock == nil {
+		return errors.New("empty ciphertext")
+	}
+	pad := lastBlock[aes.BlockSize-1]
+	if pad == 0 || pad > aes.BlockSize {
+		return fmt.Errorf("invalid pad value (%d)", pad)
+	}
+	if _, err = out.Write(lastBlock[:aes.BlockSize-int(pad)]); err != nil {
+		return err
+	}
+	if size >= 0 && out.written < size {
+		return fmt.Errorf("decrypted attachment length %v < expected %v", out.written, size)
+	}
+	return nil
+}
+
+// truncatingWriter writes up to remaining bytes to w and silently drops the rest
+type truncatingWriter struct {
+	w         io.Writer
+	remaining int64
+	written   int64
+}
+
+func (t *truncatingWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if int64(len(p)) > t.remaining {
+		p = p[:t.remaining]
+	}
+	if len(p) > 0 {
+		written, err := t.w.Write(p)
+		t.remaining -= int64(written)
+		t.written += int64(written)
+		if err != nil {
+			return written, err
+		}
+	}
+	return n, nil
+}
+
+// cbcEncryptingWriter encrypts everything written to it with AES-CBC, and PKCS#7 pads the last block on Close
+type cbcEncryptingWriter struct {
+	mode cipher.BlockMode
+	w    io.Writer
+	buf  []byte
+}
+
+func (c *cbcEncryptingWriter) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	full := len(c.buf) - len(c.buf)%aes.BlockSize
+	if full > 0 {
+		c.mode.CryptBlocks(c.buf[:full], c.buf[:full])
+		if _, err := c.w.Write(c.buf[:full]); err != nil {
+			return 0, err
+		}
+		c.buf = append(c.buf[:0], c.buf[full:]...)
+	}
+	return len(p), nil
+}
+
+func (c *cbcEncryptingWriter) Close() error {
+	pad := aes.BlockSize - len(c.buf)%aes.BlockSize
+	c.buf = append(c.buf, bytes.Repeat([]byte{byte(pad)}, pad)...)
+	c.mode.CryptBlocks(c.buf, c.buf)
+	_, err := c.w.Write(c.buf)
+	c.buf = nil
+	return err
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// encryptAttachment pads, encrypts and MACs the plaintext read from r and writes it to w.
+// Returns the size of the plaintext and the digest of the encrypted attachment.
+func encryptAttachment(r io.Reader, w io.Writer, keys []byte, maxSize int64) (int64, []byte, error) {
+	block, err := aes.NewCipher(keys[:32])
 	if err != nil {
-		return nil, err
+		return 0, nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	randBytes(iv)
+	mac := hmac.New(sha256.New, keys[32:])
+	digest := sha256.New()
+	out := io.MultiWriter(w, mac, digest)
+	if _, err = out.Write(iv); err != nil {
+		return 0, nil, err
+	}
+
+	encrypter := &cbcEncryptingWriter{mode: cipher.NewCBCEncrypter(block, iv), w: out}
+	plaintextSize, err := io.Copy(encrypter, io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return 0, nil, err
+	} else if plaintextSize > maxSize {
+		return 0, nil, fmt.Errorf("%w (more than %d bytes)", ErrAttachmentTooLarge, maxSize)
+	}
+	if _, err = io.CopyN(encrypter, zeroReader{}, paddedAttachmentSize(plaintextSize)-plaintextSize); err != nil {
+		return 0, nil, err
 	}
-	if len(decrypted) < int(size) {
-		return nil, fmt.Errorf("decrypted attachment length %v < expected %v", len(decrypted), size)
+	if err = encrypter.Close(); err != nil {
+		return 0, nil, err
+	}
+
+	macSum := mac.Sum(nil)
+	if _, err = w.Write(macSum); err != nil {
+		return 0, nil, err
 	}
-	return decrypted[:size], nil
+	digest.Write(macSum)
+	return plaintextSize, digest.Sum(nil), nil
 }
 
+// attachmentV3UploadAttributes is the upload form of the v3 and v4 attachment endpoints, which have the same fields
 type attachmentV3UploadAttributes struct {
 	Cdn                  uint32            `json:"cdn"`
 	Key                  string            `json:"key"`
@@ -85,42 +325,106 @@ type attachmentV3UploadAttributes struct {
 }
 
 func encryptAndUploadAttachment(device *Device, body []byte, mimeType, filename string) (*signalpb.AttachmentPointer, error) {
+	ap, err := UploadAttachmentReader(device, bytes.NewReader(body), mimeType, filename, math.MaxUint32)
+	return (*signalpb.AttachmentPointer)(ap), err
+}
+
+// UploadAttachmentReader encrypts an attachment read from r into a temp file and uploads it to the Signal CDN.
+// Attachments larger than maxSize bytes are rejected with ErrAttachmentTooLarge.
+func UploadAttachmentReader(device *Device, r io.Reader, mimeType, filename string, maxSize int64) (*AttachmentPointer, error) {
+	if maxSize > math.MaxUint32 {
+		// The size of attachments is an uint32
+		maxSize = math.MaxUint32
+	}
 	keys := make([]byte, 64) // combined AES and MAC keys
 	randBytes(keys)
-	plaintextLength := uint32(len(body))
 
-	// Padded length uses exponential bracketing
-	paddedLen := int(math.Max(541, math.Floor(math.Pow(1.05, math.Ceil(math.Log(float64(len(body)))/math.Log(1.05))))))
-	if paddedLen < len(body) {
-		log.Debug().Msgf("encryptAndUploadAttachment paddedLen %v < len %v. Continuing with a privacy risk.", paddedLen, len(body))
-	} else {
-		body = append(body, bytes.Repeat([]byte{0}, int(paddedLen)-len(body))...)
+	tempFile, err := os.CreateTemp("", "signalmeow-upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tempFile.Close()
+		_ = os.Remove(tempFile.Name())
+	}()
+	plaintextSize, digest, err := encryptAttachment(r, tempFile, keys, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	encryptedSize, err := tempFile.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tempFile.Seek(0, io.SeekStart); err != nil {
+		return nil, err
 	}
 
-	encrypted, err := aesEncrypt(keys[:32], body)
+	uploadAttributes, err := uploadEncryptedAttachment(device, tempFile, encryptedSize)
 	if err != nil {
 		return nil, err
 	}
-	encryptedWithMAC := appendMAC(keys[32:], encrypted)
 
-	// Get upload attributes from Signal server
-	attributesPath := "/v3/attachments/form/upload"
+	plaintextLength := uint32(plaintextSize)
+	attachmentPointer := &signalpb.AttachmentPointer{
+		AttachmentIdentifier: &signalpb.AttachmentPointer_CdnKey{
+			CdnKey: uploadAttributes.Key,
+		},
+		Key:         keys,
+		Digest:      digest,
+		Size:        &plaintextLength,
+		FileName:    &filename,
+		ContentType: &mimeType,
+		CdnNumber:   &uploadAttributes.Cdn,
+	}
+
+	return (*AttachmentPointer)(attachmentPointer), nil
+}
+
+// fetchUploadAttributes gets an upload form for a new attachment from the Signal server
+func fetchUploadAttributes(device *Device, attributesPath string) (*attachmentV3UploadAttributes, error) {
 	username, password := device.Data.BasicAuthCreds()
 	opts := &web.HTTPReqOpt{Username: &username, Password: &password}
 	resp, err := web.SendHTTPRequest("GET", attributesPath, opts)
 	if err != nil {
-		log.Err(err).Msg("Error sending request fetching upload attributes")
-		return nil, err
+		return nil, fmt.Errorf("error sending request fetching upload attributes: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("error fetching upload attributes: %v", resp.Status)
 	}
 	var uploadAttributes attachmentV3UploadAttributes
 	err = web.DecodeHTTPResponseBody(&uploadAttributes, resp)
 	if err != nil {
-		log.Err(err).Msg("Error decoding response body fetching upload attributes")
-		return nil, err
+		return nil, fmt.Errorf("error decoding response body fetching upload attributes: %w", err)
+	}
+	return &uploadAttributes, nil
+}
+
+// uploadEncryptedAttachment uploads an encrypted attachment of the given size to the CDN the server picks for it
+func uploadEncryptedAttachment(device *Device, body io.ReadSeeker, size int64) (*attachmentV3UploadAttributes, error) {
+	// The v4 form can send uploads to CDN3, the v3 form only ever uses CDN2
+	uploadAttributes, err := fetchUploadAttributes(device, "/v4/attachments/form/upload")
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to get v4 upload form, falling back to v3")
+		uploadAttributes, err = fetchUploadAttributes(device, "/v3/attachments/form/upload")
+		if err != nil {
+			log.Err(err).Msg("Error fetching upload attributes")
+			return nil, err
+		}
 	}
+	username, password := device.Data.BasicAuthCreds()
 
-	// Allocate attachment on CDN
-	resp, err = web.SendHTTPRequest("POST", "", &web.HTTPReqOpt{
+	if uploadAttributes.Cdn == 3 {
+		// CDN3 uses resumable uploads authorized by the headers of the form
+		err = web.TUSUpload(uploadAttributes.SignedUploadLocation, uploadAttributes.Key, uploadAttributes.Headers, body, size)
+		if err != nil {
+			log.Err(err).Msg("Error uploading attachment to CDN3")
+			return nil, err
+		}
+		return uploadAttributes, nil
+	}
+
+	// Allocate attachment on CDN2
+	resp, err := web.SendHTTPRequest("POST", "", &web.HTTPReqOpt{
 		OverrideURL: uploadAttributes.SignedUploadLocation,
 		ContentType: web.ContentTypeOctetStream,
 		Headers:     uploadAttributes.Headers,
@@ -140,7 +444,8 @@ func encryptAndUploadAttachment(device *Device, body []byte, mimeType, filename
 	// Upload attachment to CDN
 	resp, err = web.SendHTTPRequest("PUT", "", &web.HTTPReqOpt{
 		OverrideURL: resp.Header.Get("Location"),
-		Body:        encryptedWithMAC,
+		BodyStream:  body,
+		BodyLength:  size,
 		ContentType: web.ContentTypeOctetStream,
 		Username:    &username,
 		Password:    &password,
@@ -154,53 +459,7 @@ func encryptAndUploadAttachment(device *Device, body []byte, mimeType, filename
 		log.Err(err).Msg("Error uploading attachment")
 		return nil, err
 	}
-
-	// Calculate digest
-	hasher := sha256.New()
-	hasher.Write(encryptedWithMAC)
-	digest := hasher.Sum(nil)
-
-	attachmentPointer := &signalpb.AttachmentPointer{
-		AttachmentIdentifier: &signalpb.AttachmentPointer_CdnKey{
-			CdnKey: uploadAttributes.Key,
-		},
-		Key:         keys,
-		Digest:      digest,
-		Size:        &plaintextLength,
-		FileName:    &filename,
-		ContentType: &mimeType,
-		CdnNumber:   &uploadAttributes.Cdn,
-	}
-
-	return attachmentPointer, nil
-}
-
-func verifyMAC(key, body, mac []byte) bool {
-	m := hmac.New(sha256.New, key)
-	m.Write(body)
-	return hmac.Equal(m.Sum(nil), mac)
-}
-
-func aesDecrypt(key, ciphertext []byte) ([]byte, error) {
-	block, err := aes.NewCipher(key)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(ciphertext)%aes.BlockSize != 0 {
-		length := len(ciphertext) % aes.BlockSize
-		log.Debug().Msgf("aesDecrypt ciphertext not multiple of AES blocksize: %v", length)
-		return nil, errors.New("ciphertext not multiple of AES blocksize")
-	}
-
-	iv := ciphertext[:aes.BlockSize]
-	mode := cipher.NewCBCDecrypter(block, iv)
-	mode.CryptBlocks(ciphertext, ciphertext)
-	pad := ciphertext[len(ciphertext)-1]
-	if pad > aes.BlockSize {
-		return nil, fmt.Errorf("pad value (%d) larger than AES blocksize (%d)", pad, aes.BlockSize)
-	}
-	return ciphertext[aes.BlockSize : len(ciphertext)-int(pad)], nil
+	return uploadAttributes, nil
 }
 
 func randBytes(data []byte) {
@@ -208,28 +467,3 @@ func randBytes(data []byte) {
 		panic(err)
 	}
 }
-
-func appendMAC(key, body []byte) []byte {
-	m := hmac.New(sha256.New, key)
-	m.Write(body)
-	return m.Sum(body)
-}
-
-func aesEncrypt(key, plaintext []byte) ([]byte, error) {
-	block, err := aes.NewCipher(key)
-	if err != nil {
-		return nil, err
-	}
-
-	pad := aes.BlockSize - len(plaintext)%aes.BlockSize
-	plaintext = append(plaintext, bytes.Repeat([]byte{byte(pad)}, pad)...)
-
-	ciphertext := make([]byte, len(plaintext))
-	iv := make([]byte, 16)
-	randBytes(iv)
-
-	mode := cipher.NewCBCEncrypter(block, iv)
-	mode.CryptBlocks(ciphertext, plaintext)
-
-	return append(iv, ciphertext...), nil
-}
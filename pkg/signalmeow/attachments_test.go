@@ -0,0 +1,151 @@
+package signalmeow
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+)
+
+func randomAttachmentKeys(t *testing.T) []byte {
+	keys := make([]byte, 64)
+	if _, err := rand.Read(keys); err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+// encryptTestAttachment encrypts plaintext and returns the encrypted attachment and a pointer to it
+func encryptTestAttachment(t *testing.T, plaintext []byte) ([]byte, *signalpb.AttachmentPointer) {
+	keys := randomAttachmentKeys(t)
+	var encrypted bytes.Buffer
+	size, digest, err := encryptAttachment(bytes.NewReader(plaintext), &encrypted, keys, DefaultMaxAttachmentSize)
+	if err != nil {
+		t.Fatalf("encrypting failed: %v", err)
+	}
+	if size != int64(len(plaintext)) {
+		t.Fatalf("expected plaintext size %d, got %d", len(plaintext), size)
+	}
+	size32 := uint32(size)
+	return encrypted.Bytes(), &signalpb.AttachmentPointer{Key: keys, Digest: digest, Size: &size32}
+}
+
+func TestAttachmentRoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, 15, 16, 17, 540, 541, 542, 4096, 100000} {
+		plaintext := make([]byte, size)
+		if _, err := rand.Read(plaintext); err != nil {
+			t.Fatal(err)
+		}
+		encrypted, pointer := encryptTestAttachment(t, plaintext)
+		if int64(len(encrypted)) != encryptedAttachmentSize(int64(size)) {
+			t.Errorf("size %d: expected %d encrypted bytes, got %d", size, encryptedAttachmentSize(int64(size)), len(encrypted))
+		}
+		var decrypted bytes.Buffer
+		err := verifyAndDecryptAttachment(bytes.NewReader(encrypted), pointer, &decrypted, DefaultMaxAttachmentSize)
+		if err != nil {
+			t.Errorf("size %d: decrypting failed: %v", size, err)
+		} else if !bytes.Equal(decrypted.Bytes(), plaintext) {
+			t.Errorf("size %d: decrypted attachment doesn't match", size)
+		}
+	}
+}
+
+func TestAttachmentTamperedMAC(t *testing.T) {
+	encrypted, pointer := encryptTestAttachment(t, []byte("hello world"))
+	// Without a digest, the MAC is the only thing that catches tampering
+	pointer.Digest = nil
+	for _, index := range []int{0, aes.BlockSize + 1, len(encrypted) - 1} {
+		tampered := bytes.Clone(encrypted)
+		tampered[index] ^= 0x01
+		err := verifyAndDecryptAttachment(bytes.NewReader(tampered), pointer, &bytes.Buffer{}, DefaultMaxAttachmentSize)
+		if !errors.Is(err, ErrInvalidMACForAttachment) {
+			t.Errorf("byte %d: expected invalid MAC error, got %v", index, err)
+		}
+	}
+}
+
+func TestAttachmentTamperedDigest(t *testing.T) {
+	encrypted, pointer := encryptTestAttachment(t, []byte("hello world"))
+	tampered := bytes.Clone(encrypted)
+	tampered[aes.BlockSize+1] ^= 0x01
+	err := verifyAndDecryptAttachment(bytes.NewReader(tampered), pointer, &bytes.Buffer{}, DefaultMaxAttachmentSize)
+	if !errors.Is(err, ErrInvalidDigestForAttachment) {
+		t.Errorf("expected invalid digest error for tampered attachment, got %v", err)
+	}
+
+	pointer.Digest[0] ^= 0x01
+	err = verifyAndDecryptAttachment(bytes.NewReader(encrypted), pointer, &bytes.Buffer{}, DefaultMaxAttachmentSize)
+	if !errors.Is(err, ErrInvalidDigestForAttachment) {
+		t.Errorf("expected invalid digest error for tampered digest, got %v", err)
+	}
+}
+
+func TestAttachmentTooLarge(t *testing.T) {
+	plaintext := make([]byte, 2000)
+	_, _, err := encryptAttachment(bytes.NewReader(plaintext), &bytes.Buffer{}, randomAttachmentKeys(t), 1999)
+	if !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("expected too large error when encrypting, got %v", err)
+	}
+	_, _, err = encryptAttachment(bytes.NewReader(plaintext), &bytes.Buffer{}, randomAttachmentKeys(t), 2000)
+	if err != nil {
+		t.Errorf("attachment of exactly the maximum size was rejected: %v", err)
+	}
+
+	encrypted, pointer := encryptTestAttachment(t, plaintext)
+	err = verifyAndDecryptAttachment(bytes.NewReader(encrypted), pointer, &bytes.Buffer{}, 1000)
+	if !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("expected too large error when decrypting, got %v", err)
+	}
+}
+
+func TestCBCEncryptingWriterPadding(t *testing.T) {
+	key := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, size := range []int{0, 1, aes.BlockSize - 1, aes.BlockSize, aes.BlockSize + 1, 2 * aes.BlockSize, 1000} {
+		plaintext := bytes.Repeat([]byte{0xAB}, size)
+		var ciphertext bytes.Buffer
+		writer := &cbcEncryptingWriter{mode: cipher.NewCBCEncrypter(block, iv), w: &ciphertext}
+		// Write in uneven chunks, so blocks are split across writes
+		for i := 0; i < size; i += 7 {
+			end := i + 7
+			if end > size {
+				end = size
+			}
+			if _, err = writer.Write(plaintext[i:end]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err = writer.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		// There's always padding, a full block of it if the plaintext ends at a block boundary
+		pad := aes.BlockSize - size%aes.BlockSize
+		if ciphertext.Len() != size+pad {
+			t.Errorf("size %d: expected %d bytes of ciphertext, got %d", size, size+pad, ciphertext.Len())
+			continue
+		}
+		decrypted := make([]byte, ciphertext.Len())
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, ciphertext.Bytes())
+		if !bytes.Equal(decrypted[:size], plaintext) || !bytes.Equal(decrypted[size:], bytes.Repeat([]byte{byte(pad)}, pad)) {
+			t.Errorf("size %d: decrypted data or padding doesn't match", size)
+		}
+
+		// decryptAttachment removes the padding by itself when the size isn't known
+		var unpadded bytes.Buffer
+		err = decryptAttachment(bytes.NewReader(append(bytes.Clone(iv), ciphertext.Bytes()...)), &unpadded, key, -1)
+		if err != nil {
+			t.Errorf("size %d: decrypting failed: %v", size, err)
+		} else if !bytes.Equal(unpadded.Bytes(), plaintext) {
+			t.Errorf("size %d: expected %d bytes after removing padding, got %d", size, size, unpadded.Len())
+		}
+	}
+}
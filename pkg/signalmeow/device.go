@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"sync"
 
 	"go.mau.fi/mautrix-signal/pkg/libsignalgo"
 	"go.mau.fi/mautrix-signal/pkg/signalmeow/web"
@@ -33,11 +34,14 @@ func (d *DeviceData) BasicAuthCreds() (string, string) {
 // and other data that is used to communicate with the Signal servers and other clients.
 type DeviceConnection struct {
 	// cached data (not persisted)
-	SenderCertificate *libsignalgo.SenderCertificate
-	GroupCredentials  *GroupCredentials
-	GroupCache        *GroupCache
-	ProfileCache      *ProfileCache
-	GroupCallCache    *map[string]bool
+	SenderCertificate      *libsignalgo.SenderCertificate
+	GroupCredentials       *GroupCredentials
+	GroupCache             *GroupCache
+	ProfileCache           *ProfileCache
+	GroupCallCache         *map[string]bool
+	SentMessageCache       *SentMessageCache
+	DecryptionErrorLimiter *DecryptionErrorLimiter
+	retryStateOnce         sync.Once
 	// Network interfaces
 	AuthedWS   *web.SignalWebsocket
 	UnauthedWS *web.SignalWebsocket
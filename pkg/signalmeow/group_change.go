@@ -0,0 +1,1034 @@
+package signalmeow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+
+	"go.mau.fi/mautrix-signal/pkg/libsignalgo"
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+	"go.mau.fi/mautrix-signal/pkg/signalmeow/web"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrInvalidGroupChangeSignature = errors.New("invalid server signature for group change")
+
+// GroupChange is a decrypted GroupChange.Actions, i.e. everything that changed in a single group revision.
+// Fields for things that didn't change are nil or empty.
+type GroupChange struct {
+	GroupIdentifier GroupIdentifier
+	Editor          string // ACI of whoever made the change
+	Revision        uint32
+
+	AddMembers               []*GroupMember
+	DeleteMembers            []string
+	ModifyMemberRoles        []*GroupMember // Only UserId and Role are set
+	ModifyMemberProfileKeys  []*GroupMember // Only UserId and ProfileKey are set
+	AddPendingMembers        []*PendingMember
+	DeletePendingMembers     []string
+	PromotePendingMembers    []*GroupMember // Invited users that accepted the invite
+	AddRequestingMembers     []*RequestingMember
+	DeleteRequestingMembers  []string
+	PromoteRequestingMembers []*GroupMember // Only UserId and Role are set
+	AddBannedMembers         []*BannedMember
+	DeleteBannedMembers      []string
+
+	ModifyTitle                     *string
+	ModifyAvatar                    *string // The new avatar path, or an empty string if the avatar was removed
+	ModifyDescription               *string
+	ModifyDisappearingMessagesTimer *uint32
+	ModifyAttributesAccess          *AccessControl
+	ModifyMemberAccess              *AccessControl
+	ModifyAddFromInviteLinkAccess   *AccessControl
+	ModifyInviteLinkPassword        []byte // The invite link was reset, this is the new password
+	ModifyAnnouncementsOnly         *bool
+}
+
+// decryptGroupChange decodes the signed GroupChange sent along with a group update message,
+// after checking that it was signed by the group server.
+func decryptGroupChange(groupMasterKey SerializedGroupMasterKey, encryptedGroupChange []byte) (*GroupChange, error) {
+	signedGroupChange := &signalpb.GroupChange{}
+	err := proto.Unmarshal(encryptedGroupChange, signedGroupChange)
+	if err != nil {
+		zlog.Err(err).Msg("GroupChange Unmarshal error")
+		return nil, err
+	}
+	err = serverPublicParams().VerifySignature(signedGroupChange.Actions, signedGroupChange.ServerSignature)
+	if err != nil {
+		zlog.Err(err).Msg("GroupChange server signature verification error")
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGroupChangeSignature, err)
+	}
+	actions := &signalpb.GroupChange_Actions{}
+	err = proto.Unmarshal(signedGroupChange.Actions, actions)
+	if err != nil {
+		zlog.Err(err).Msg("GroupChange.Actions Unmarshal error")
+		return nil, err
+	}
+
+	groupSecretParams, err := libsignalgo.DeriveGroupSecretParamsFromMasterKey(masterKeyToBytes(groupMasterKey))
+	if err != nil {
+		zlog.Err(err).Msg("DeriveGroupSecretParamsFromMasterKey error")
+		return nil, err
+	}
+	gid, err := groupIdentifierFromMasterKey(groupMasterKey)
+	if err != nil {
+		return nil, err
+	}
+	change := &GroupChange{
+		GroupIdentifier: gid,
+		Revision:        actions.Revision,
+	}
+	if len(actions.SourceUuid) > 0 {
+		change.Editor, err = decryptUserID(groupSecretParams, actions.SourceUuid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, action := range actions.AddMembers {
+		member, err := decryptMember(groupSecretParams, action.GetAdded())
+		if err != nil {
+			return nil, err
+		}
+		change.AddMembers = append(change.AddMembers, member)
+	}
+	for _, action := range actions.DeleteMembers {
+		userID, err := decryptUserID(groupSecretParams, action.DeletedUserId)
+		if err != nil {
+			return nil, err
+		}
+		change.DeleteMembers = append(change.DeleteMembers, userID)
+	}
+	for _, action := range actions.ModifyMemberRoles {
+		userID, err := decryptUserID(groupSecretParams, action.UserId)
+		if err != nil {
+			return nil, err
+		}
+		change.ModifyMemberRoles = append(change.ModifyMemberRoles, &GroupMember{
+			UserId: userID,
+			Role:   GroupMemberRole(action.Role),
+		})
+	}
+	for _, action := range actions.ModifyMemberProfileKeys {
+		userID, profileKey, err := decryptUserIDAndProfileKey(groupSecretParams, action.UserId, action.ProfileKey)
+		if err != nil {
+			return nil, err
+		}
+		change.ModifyMemberProfileKeys = append(change.ModifyMemberProfileKeys, &GroupMember{
+			UserId:     userID,
+			ProfileKey: *profileKey,
+		})
+	}
+	for _, action := range actions.AddPendingMembers {
+		pendingMember, err := decryptPendingMember(groupSecretParams, action.GetAdded())
+		if err != nil {
+			return nil, err
+		}
+		change.AddPendingMembers = append(change.AddPendingMembers, pendingMember)
+	}
+	for _, action := range actions.DeletePendingMembers {
+		userID, err := decryptUserID(groupSecretParams, action.DeletedUserId)
+		if err != nil {
+			return nil, err
+		}
+		change.DeletePendingMembers = append(change.DeletePendingMembers, userID)
+	}
+	for _, action := range actions.PromotePendingMembers {
+		userID, profileKey, err := decryptUserIDAndProfileKey(groupSecretParams, action.UserId, action.ProfileKey)
+		if err != nil {
+			return nil, err
+		}
+		change.PromotePendingMembers = append(change.PromotePendingMembers, &GroupMember{
+			UserId:           userID,
+			ProfileKey:       *profileKey,
+			Role:             GroupMember_DEFAULT,
+			JoinedAtRevision: actions.Revision,
+		})
+	}
+	for _, action := range actions.PromotePendingPniAciMembers {
+		userID, profileKey, err := decryptUserIDAndProfileKey(groupSecretParams, action.UserId, action.ProfileKey)
+		if err != nil {
+			return nil, err
+		}
+		pni, err := decryptUserID(groupSecretParams, action.Pni)
+		if err != nil {
+			return nil, err
+		}
+		// The invite was for the PNI, so that's what the pending member was known as
+		change.DeletePendingMembers = append(change.DeletePendingMembers, pni)
+		change.PromotePendingMembers = append(change.PromotePendingMembers, &GroupMember{
+			UserId:           userID,
+			ProfileKey:       *profileKey,
+			Role:             GroupMember_DEFAULT,
+			JoinedAtRevision: actions.Revision,
+		})
+	}
+	for _, action := range actions.AddRequestingMembers {
+		requestingMember, err := decryptRequestingMember(groupSecretParams, action.GetAdded())
+		if err != nil {
+			return nil, err
+		}
+		change.AddRequestingMembers = append(change.AddRequestingMembers, requestingMember)
+	}
+	for _, action := range actions.DeleteRequestingMembers {
+		userID, err := decryptUserID(groupSecretParams, action.DeletedUserId)
+		if err != nil {
+			return nil, err
+		}
+		change.DeleteRequestingMembers = append(change.DeleteRequestingMembers, userID)
+	}
+	for _, action := range actions.PromoteRequestingMembers {
+		userID, err := decryptUserID(groupSecretParams, action.UserId)
+		if err != nil {
+			return nil, err
+		}
+		change.PromoteRequestingMembers = append(change.PromoteRequestingMembers, &GroupMember{
+			UserId:           userID,
+			Role:             GroupMemberRole(action.Role),
+			JoinedAtRevision: actions.Revision,
+		})
+	}
+	for _, action := range actions.AddBannedMembers {
+		bannedMember, err := decryptBannedMember(groupSecretParams, action.GetAdded())
+		if err != nil {
+			return nil, err
+		}
+		change.AddBannedMembers = append(change.AddBannedMembers, bannedMember)
+	}
+	for _, action := range actions.DeleteBannedMembers {
+		userID, err := decryptUserID(groupSecretParams, action.DeletedUserId)
+		if err != nil {
+			return nil, err
+		}
+		change.DeleteBannedMembers = append(change.DeleteBannedMembers, userID)
+	}
+
+	if actions.ModifyTitle != nil {
+		blob, err := decryptGroupAttributeBlob(groupSecretParams, actions.ModifyTitle.Title)
+		if err != nil {
+			return nil, err
+		}
+		title := blob.GetTitle()
+		change.ModifyTitle = &title
+	}
+	if actions.ModifyAvatar != nil {
+		change.ModifyAvatar = &actions.ModifyAvatar.Avatar
+	}
+	if actions.ModifyDescription != nil {
+		blob, err := decryptGroupAttributeBlob(groupSecretParams, actions.ModifyDescription.Description)
+		if err != nil {
+			return nil, err
+		}
+		description := blob.GetDescription()
+		change.ModifyDescription = &description
+	}
+	if actions.ModifyDisappearingMessagesTimer != nil {
+		blob, err := decryptGroupAttributeBlob(groupSecretParams, actions.ModifyDisappearingMessagesTimer.Timer)
+		if err != nil {
+			return nil, err
+		}
+		timer := blob.GetDisappearingMessagesDuration()
+		change.ModifyDisappearingMessagesTimer = &timer
+	}
+	if actions.ModifyAttributesAccess != nil {
+		access := AccessControl(actions.ModifyAttributesAccess.AttributesAccess)
+		change.ModifyAttributesAccess = &access
+	}
+	if actions.ModifyMemberAccess != nil {
+		access := AccessControl(actions.ModifyMemberAccess.MembersAccess)
+		change.ModifyMemberAccess = &access
+	}
+	if actions.ModifyAddFromInviteLinkAccess != nil {
+		access := AccessControl(actions.ModifyAddFromInviteLinkAccess.AddFromInviteLinkAccess)
+		change.ModifyAddFromInviteLinkAccess = &access
+	}
+	if actions.ModifyInviteLinkPassword != nil {
+		change.ModifyInviteLinkPassword = actions.ModifyInviteLinkPassword.InviteLinkPassword
+	}
+	if actions.ModifyAnnouncementsOnly != nil {
+		change.ModifyAnnouncementsOnly = &actions.ModifyAnnouncementsOnly.AnnouncementsOnly
+	}
+	return change, nil
+}
+
+// applyGroupChange updates a group with a change that was made on top of it.
+// Returns false if the change isn't the next revision of the group, in which case
+// the group needs to be fetched from the server again.
+func applyGroupChange(group *Group, change *GroupChange) bool {
+	if change.Revision != group.Revision+1 {
+		return false
+	}
+	group.Revision = change.Revision
+
+	removedMembers := make(map[string]bool)
+	for _, userID := range change.DeleteMembers {
+		removedMembers[userID] = true
+	}
+	members := make([]*GroupMember, 0, len(group.Members))
+	for _, member := range group.Members {
+		if !removedMembers[member.UserId] {
+			members = append(members, member)
+		}
+	}
+	members = append(members, change.AddMembers...)
+	members = append(members, change.PromotePendingMembers...)
+	members = append(members, change.PromoteRequestingMembers...)
+	for _, modified := range change.ModifyMemberRoles {
+		for _, member := range members {
+			if member.UserId == modified.UserId {
+				member.Role = modified.Role
+			}
+		}
+	}
+	for _, modified := range change.ModifyMemberProfileKeys {
+		for _, member := range members {
+			if member.UserId == modified.UserId {
+				member.ProfileKey = modified.ProfileKey
+			}
+		}
+	}
+	group.Members = members
+
+	// Invites that were accepted or revoked aren't pending anymore
+	noLongerPending := make(map[string]bool)
+	for _, userID := range change.DeletePendingMembers {
+		noLongerPending[userID] = true
+	}
+	for _, member := range change.PromotePendingMembers {
+		noLongerPending[member.UserId] = true
+	}
+	pendingMembers := make([]*PendingMember, 0, len(group.PendingMembers))
+	for _, pendingMember := range group.PendingMembers {
+		if !noLongerPending[pendingMember.UserId] {
+			pendingMembers = append(pendingMembers, pendingMember)
+		}
+	}
+	group.PendingMembers = append(pendingMembers, change.AddPendingMembers...)
+
+	noLongerRequesting := make(map[string]bool)
+	for _, userID := range change.DeleteRequestingMembers {
+		noLongerRequesting[userID] = true
+	}
+	for _, member := range change.PromoteRequestingMembers {
+		noLongerRequesting[member.UserId] = true
+	}
+	requestingMembers := make([]*RequestingMember, 0, len(group.RequestingMembers))
+	for _, requestingMember := range group.RequestingMembers {
+		if noLongerRequesting[requestingMember.UserId] {
+			// Approved members only come with a role, their profile key is in the request
+			for _, member := range change.PromoteRequestingMembers {
+				if member.UserId == requestingMember.UserId {
+					member.ProfileKey = requestingMember.ProfileKey
+				}
+			}
+		} else {
+			requestingMembers = append(requestingMembers, requestingMember)
+		}
+	}
+	group.RequestingMembers = append(requestingMembers, change.AddRequestingMembers...)
+
+	unbanned := make(map[string]bool)
+	for _, userID := range change.DeleteBannedMembers {
+		unbanned[userID] = true
+	}
+	bannedMembers := make([]*BannedMember, 0, len(group.BannedMembers))
+	for _, bannedMember := range group.BannedMembers {
+		if !unbanned[bannedMember.UserId] {
+			bannedMembers = append(bannedMembers, bannedMember)
+		}
+	}
+	group.BannedMembers = append(bannedMembers, change.AddBannedMembers...)
+
+	if group.AccessControl == nil {
+		group.AccessControl = &GroupAccessControl{}
+	}
+	if change.ModifyAttributesAccess != nil {
+		group.AccessControl.Attributes = *change.ModifyAttributesAccess
+	}
+	if change.ModifyMemberAccess != nil {
+		group.AccessControl.Members = *change.ModifyMemberAccess
+	}
+	if change.ModifyAddFromInviteLinkAccess != nil {
+		group.AccessControl.AddFromInviteLink = *change.ModifyAddFromInviteLinkAccess
+	}
+
+	if change.ModifyTitle != nil {
+		group.Title = *change.ModifyTitle
+	}
+	if change.ModifyAvatar != nil {
+		group.AvatarPath = *change.ModifyAvatar
+	}
+	if change.ModifyDescription != nil {
+		group.Description = *change.ModifyDescription
+	}
+	if change.ModifyAnnouncementsOnly != nil {
+		group.AnnouncementsOnly = *change.ModifyAnnouncementsOnly
+	}
+	if change.ModifyDisappearingMessagesTimer != nil {
+		group.DisappearingMessagesTimer = *change.ModifyDisappearingMessagesTimer
+	}
+	if change.ModifyInviteLinkPassword != nil {
+		group.InviteLinkPassword = change.ModifyInviteLinkPassword
+	}
+	return true
+}
+
+// updateGroupCacheWithChange applies a group change to our cached copy of the group,
+// or invalidates the cache if we missed a change in between.
+func (d *Device) updateGroupCacheWithChange(change *GroupChange) {
+	d.initGroupCache()
+	group, ok := d.Connection.GroupCache.groups[change.GroupIdentifier]
+	if !ok {
+		return
+	}
+	if !applyGroupChange(group, change) {
+		zlog.Debug().Msgf("Group %v change revision %v doesn't follow our revision %v, invalidating cache", change.GroupIdentifier, change.Revision, group.Revision)
+		InvalidateGroupCache(d, change.GroupIdentifier)
+	}
+}
+
+// storeGroupChangeProfileKeys saves the profile keys of members that joined or changed their key
+func storeGroupChangeProfileKeys(ctx context.Context, d *Device, change *GroupChange) {
+	var members []*GroupMember
+	members = append(members, change.AddMembers...)
+	members = append(members, change.PromotePendingMembers...)
+	members = append(members, change.ModifyMemberProfileKeys...)
+	for _, member := range members {
+		err := d.ProfileKeyStore.StoreProfileKey(member.UserId, member.ProfileKey, ctx)
+		if err != nil {
+			zlog.Err(err).Msg("GroupChange StoreProfileKey error")
+		}
+	}
+}
+
+// encryptGroupAttributeBlob encrypts a GroupAttributeBlob (title, description, timer, etc.) for the group server
+func encryptGroupAttributeBlob(groupSecretParams libsignalgo.GroupSecretParams, blob *signalpb.GroupAttributeBlob) ([]byte, error) {
+	plaintext, err := proto.Marshal(blob)
+	if err != nil {
+		return nil, err
+	}
+	randomness, err := libsignalgo.GenerateRandomness()
+	if err != nil {
+		return nil, err
+	}
+	return groupSecretParams.EncryptBlobWithPadding(randomness, plaintext, 0)
+}
+
+// patchGroup sends a set of group change actions to the group server. The revision of the actions
+// is set to the one after the given group's, and the signed group change is returned.
+func patchGroup(ctx context.Context, d *Device, group *Group, actions *signalpb.GroupChange_Actions) (*signalpb.GroupChange, error) {
+	actions.Revision = group.Revision + 1
+	groupChange, err := sendGroupPatch(ctx, d, group.groupMasterKey, actions, "/v1/groups/")
+	// The group changed on the server whether or not our change went through
+	InvalidateGroupCache(d, group.GroupIdentifier)
+	return groupChange, err
+}
+
+// sendGroupPatch PATCHes group change actions to the given path on the group server
+func sendGroupPatch(ctx context.Context, d *Device, groupMasterKey SerializedGroupMasterKey, actions *signalpb.GroupChange_Actions, path string) (*signalpb.GroupChange, error) {
+	actionsBytes, err := proto.Marshal(actions)
+	if err != nil {
+		return nil, err
+	}
+	groupAuth, err := GetAuthorizationForToday(ctx, d, masterKeyToBytes(groupMasterKey))
+	if err != nil {
+		return nil, err
+	}
+	opts := &web.HTTPReqOpt{
+		Body:        actionsBytes,
+		Username:    &groupAuth.Username,
+		Password:    &groupAuth.Password,
+		ContentType: web.ContentTypeProtobuf,
+		Host:        web.StorageUrlHost,
+	}
+	response, err := web.SendHTTPRequest("PATCH", path, opts)
+	if err != nil {
+		zlog.Err(err).Msg("patchGroup SendHTTPRequest error")
+		return nil, err
+	}
+	if response.StatusCode != 200 {
+		err := fmt.Errorf("patchGroup SendHTTPRequest bad status: %v", response.StatusCode)
+		zlog.Err(err).Msg("")
+		return nil, err
+	}
+	groupChangeBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		zlog.Err(err).Msg("patchGroup ReadAll error")
+		return nil, err
+	}
+	groupChange := &signalpb.GroupChange{}
+	err = proto.Unmarshal(groupChangeBytes, groupChange)
+	if err != nil {
+		zlog.Err(err).Msg("patchGroup Unmarshal error")
+		return nil, err
+	}
+	return groupChange, nil
+}
+
+// sendGroupChange tells the other members about a group change we made, so they don't have to
+// refetch the whole group to find out what happened.
+func sendGroupChange(ctx context.Context, d *Device, group *Group, groupChange *signalpb.GroupChange, expireTimer uint32) error {
+	groupChangeBytes, err := proto.Marshal(groupChange)
+	if err != nil {
+		return err
+	}
+	actions := &signalpb.GroupChange_Actions{}
+	err = proto.Unmarshal(groupChange.Actions, actions)
+	if err != nil {
+		return err
+	}
+	timestamp := currentMessageTimestamp()
+	groupContext := groupMetadataForDataMessage(*group)
+	groupContext.Revision = proto.Uint32(actions.Revision)
+	groupContext.GroupChange = groupChangeBytes
+	dm := &signalpb.DataMessage{
+		Timestamp: &timestamp,
+		GroupV2:   groupContext,
+	}
+	if expireTimer > 0 {
+		dm.ExpireTimer = proto.Uint32(expireTimer)
+	}
+	_, err = sendGroupDataMessage(ctx, d, group, (*signalpb.Content)(wrapDataMessageInContent(dm)))
+	return err
+}
+
+// SetGroupDisappearingMessagesTimer changes the disappearing message timer of a group (0 turns it off)
+func SetGroupDisappearingMessagesTimer(ctx context.Context, d *Device, gid GroupIdentifier, expireTimer uint32) error {
+	// Make sure we have the latest revision, otherwise the server will reject the change
+	InvalidateGroupCache(d, gid)
+	group, err := RetrieveGroupByID(ctx, d, gid)
+	if err != nil {
+		return err
+	}
+	groupSecretParams, err := libsignalgo.DeriveGroupSecretParamsFromMasterKey(masterKeyToBytes(group.groupMasterKey))
+	if err != nil {
+		return err
+	}
+	encryptedTimer, err := encryptGroupAttributeBlob(groupSecretParams, &signalpb.GroupAttributeBlob{
+		Content: &signalpb.GroupAttributeBlob_DisappearingMessagesDuration{
+			DisappearingMessagesDuration: expireTimer,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	groupChange, err := patchGroup(ctx, d, group, &signalpb.GroupChange_Actions{
+		ModifyDisappearingMessagesTimer: &signalpb.GroupChange_Actions_ModifyDisappearingMessagesTimerAction{
+			Timer: encryptedTimer,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return sendGroupChange(ctx, d, group, groupChange, expireTimer)
+}
+
+// modifyGroup makes a change to a group on behalf of the user: the latest revision of the group is fetched,
+// buildActions turns it into a set of change actions, which are applied on the group server and sent to
+// the other members.
+func modifyGroup(
+	ctx context.Context,
+	d *Device,
+	gid GroupIdentifier,
+	buildActions func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error),
+) error {
+	// Make sure we have the latest revision, otherwise the server will reject the change
+	InvalidateGroupCache(d, gid)
+	group, err := RetrieveGroupByID(ctx, d, gid)
+	if err != nil {
+		return err
+	}
+	groupSecretParams, err := libsignalgo.DeriveGroupSecretParamsFromMasterKey(masterKeyToBytes(group.groupMasterKey))
+	if err != nil {
+		return err
+	}
+	actions, err := buildActions(group, groupSecretParams)
+	if err != nil {
+		return err
+	}
+	groupChange, err := patchGroup(ctx, d, group, actions)
+	if err != nil {
+		return err
+	}
+	return sendGroupChange(ctx, d, group, groupChange, group.DisappearingMessagesTimer)
+}
+
+// findGroupMember returns which list of the group the user is in
+func findGroupMember(group *Group, userID string) (isMember, isPending, isRequesting, isBanned bool) {
+	for _, member := range group.Members {
+		if member.UserId == userID {
+			isMember = true
+		}
+	}
+	for _, pendingMember := range group.PendingMembers {
+		if pendingMember.UserId == userID {
+			isPending = true
+		}
+	}
+	for _, requestingMember := range group.RequestingMembers {
+		if requestingMember.UserId == userID {
+			isRequesting = true
+		}
+	}
+	for _, bannedMember := range group.BannedMembers {
+		if bannedMember.UserId == userID {
+			isBanned = true
+		}
+	}
+	return
+}
+
+// addRemoveMemberActions adds the actions that remove the user from the group, whether they're
+// a member, an invited user or someone asking to join. Returns false if they're none of those.
+func addRemoveMemberActions(actions *signalpb.GroupChange_Actions, group *Group, userID string, encryptedUserID []byte) bool {
+	isMember, isPending, isRequesting, _ := findGroupMember(group, userID)
+	switch {
+	case isMember:
+		actions.DeleteMembers = append(actions.DeleteMembers, &signalpb.GroupChange_Actions_DeleteMemberAction{
+			DeletedUserId: encryptedUserID,
+		})
+	case isPending:
+		actions.DeletePendingMembers = append(actions.DeletePendingMembers, &signalpb.GroupChange_Actions_DeletePendingMemberAction{
+			DeletedUserId: encryptedUserID,
+		})
+	case isRequesting:
+		actions.DeleteRequestingMembers = append(actions.DeleteRequestingMembers, &signalpb.GroupChange_Actions_DeleteRequestingMemberAction{
+			DeletedUserId: encryptedUserID,
+		})
+	default:
+		return false
+	}
+	return true
+}
+
+// RemoveGroupMember removes a member from a group, revokes their invite or denies their request to join
+func RemoveGroupMember(ctx context.Context, d *Device, gid GroupIdentifier, userID string) error {
+	return modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		encryptedUserID, err := encryptUserID(groupSecretParams, userID)
+		if err != nil {
+			return nil, err
+		}
+		actions := &signalpb.GroupChange_Actions{}
+		if !addRemoveMemberActions(actions, group, userID, encryptedUserID) {
+			return nil, fmt.Errorf("%s is not in the group", userID)
+		}
+		return actions, nil
+	})
+}
+
+// BanGroupMember bans a user from a group, removing them first if they're in it
+func BanGroupMember(ctx context.Context, d *Device, gid GroupIdentifier, userID string) error {
+	return modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		encryptedUserID, err := encryptUserID(groupSecretParams, userID)
+		if err != nil {
+			return nil, err
+		}
+		if _, _, _, isBanned := findGroupMember(group, userID); isBanned {
+			return nil, fmt.Errorf("%s is already banned", userID)
+		}
+		actions := &signalpb.GroupChange_Actions{
+			AddBannedMembers: []*signalpb.GroupChange_Actions_AddBannedMemberAction{{
+				Added: &signalpb.BannedMember{UserId: encryptedUserID},
+			}},
+		}
+		addRemoveMemberActions(actions, group, userID, encryptedUserID)
+		return actions, nil
+	})
+}
+
+// UnbanGroupMember lifts a user's ban from a group
+func UnbanGroupMember(ctx context.Context, d *Device, gid GroupIdentifier, userID string) error {
+	return modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		encryptedUserID, err := encryptUserID(groupSecretParams, userID)
+		if err != nil {
+			return nil, err
+		}
+		if _, _, _, isBanned := findGroupMember(group, userID); !isBanned {
+			return nil, fmt.Errorf("%s is not banned", userID)
+		}
+		return &signalpb.GroupChange_Actions{
+			DeleteBannedMembers: []*signalpb.GroupChange_Actions_DeleteBannedMemberAction{{
+				DeletedUserId: encryptedUserID,
+			}},
+		}, nil
+	})
+}
+
+// InviteGroupMember invites a user to a group. If they already asked to join, their request is approved instead.
+// Users can't be added as full members directly, as that needs a credential for their profile key.
+func InviteGroupMember(ctx context.Context, d *Device, gid GroupIdentifier, userID string) error {
+	return modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		encryptedUserID, err := encryptUserID(groupSecretParams, userID)
+		if err != nil {
+			return nil, err
+		}
+		isMember, isPending, isRequesting, isBanned := findGroupMember(group, userID)
+		actions := &signalpb.GroupChange_Actions{}
+		switch {
+		case isMember:
+			return nil, fmt.Errorf("%s is already a member", userID)
+		case isPending:
+			return nil, fmt.Errorf("%s is already invited", userID)
+		case isRequesting:
+			actions.PromoteRequestingMembers = append(actions.PromoteRequestingMembers, &signalpb.GroupChange_Actions_PromoteRequestingMemberAction{
+				UserId: encryptedUserID,
+				Role:   signalpb.Member_DEFAULT,
+			})
+		default:
+			actions.AddPendingMembers = append(actions.AddPendingMembers, &signalpb.GroupChange_Actions_AddPendingMemberAction{
+				Added: &signalpb.PendingMember{
+					Member: &signalpb.Member{
+						UserId: encryptedUserID,
+						Role:   signalpb.Member_DEFAULT,
+					},
+				},
+			})
+		}
+		if isBanned {
+			actions.DeleteBannedMembers = append(actions.DeleteBannedMembers, &signalpb.GroupChange_Actions_DeleteBannedMemberAction{
+				DeletedUserId: encryptedUserID,
+			})
+		}
+		return actions, nil
+	})
+}
+
+// SetGroupMemberRoles makes members admins or regular members, all in a single group change
+func SetGroupMemberRoles(ctx context.Context, d *Device, gid GroupIdentifier, roles map[string]GroupMemberRole) error {
+	return modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		actions := &signalpb.GroupChange_Actions{}
+		for userID, role := range roles {
+			if isMember, _, _, _ := findGroupMember(group, userID); !isMember {
+				return nil, fmt.Errorf("%s is not a member", userID)
+			}
+			encryptedUserID, err := encryptUserID(groupSecretParams, userID)
+			if err != nil {
+				return nil, err
+			}
+			actions.ModifyMemberRoles = append(actions.ModifyMemberRoles, &signalpb.GroupChange_Actions_ModifyMemberRoleAction{
+				UserId: encryptedUserID,
+				Role:   signalpb.Member_Role(role),
+			})
+		}
+		return actions, nil
+	})
+}
+
+// SetGroupTitle renames a group
+func SetGroupTitle(ctx context.Context, d *Device, gid GroupIdentifier, title string) error {
+	return modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		encryptedTitle, err := encryptGroupAttributeBlob(groupSecretParams, &signalpb.GroupAttributeBlob{
+			Content: &signalpb.GroupAttributeBlob_Title{Title: title},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &signalpb.GroupChange_Actions{
+			ModifyTitle: &signalpb.GroupChange_Actions_ModifyTitleAction{Title: encryptedTitle},
+		}, nil
+	})
+}
+
+// SetGroupDescription changes the description of a group (an empty string removes it)
+func SetGroupDescription(ctx context.Context, d *Device, gid GroupIdentifier, description string) error {
+	return modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		var encryptedDescription []byte
+		if description != "" {
+			var err error
+			encryptedDescription, err = encryptGroupAttributeBlob(groupSecretParams, &signalpb.GroupAttributeBlob{
+				Content: &signalpb.GroupAttributeBlob_Description{Description: description},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &signalpb.GroupChange_Actions{
+			ModifyDescription: &signalpb.GroupChange_Actions_ModifyDescriptionAction{Description: encryptedDescription},
+		}, nil
+	})
+}
+
+// SetGroupAvatar uploads a new avatar image for a group, or removes the avatar if avatar is empty
+func SetGroupAvatar(ctx context.Context, d *Device, gid GroupIdentifier, avatar []byte) error {
+	var avatarPath string
+	err := modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		if len(avatar) > 0 {
+			var err error
+			avatarPath, err = uploadGroupAvatar(ctx, d, group, groupSecretParams, avatar)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &signalpb.GroupChange_Actions{
+			ModifyAvatar: &signalpb.GroupChange_Actions_ModifyAvatarAction{Avatar: avatarPath},
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+	// The caller already has the image, so don't make RetrieveGroupAndAvatarByID download it again
+	d.initGroupCache()
+	d.Connection.GroupCache.avatarPaths[gid] = avatarPath
+	return nil
+}
+
+// uploadGroupAvatar encrypts an avatar image with the group's keys and uploads it to the CDN,
+// returning the path to put in the group
+func uploadGroupAvatar(ctx context.Context, d *Device, group *Group, groupSecretParams libsignalgo.GroupSecretParams, avatar []byte) (string, error) {
+	encryptedAvatar, err := encryptGroupAttributeBlob(groupSecretParams, &signalpb.GroupAttributeBlob{
+		Content: &signalpb.GroupAttributeBlob_Avatar{Avatar: avatar},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Get upload form from the group server
+	groupAuth, err := GetAuthorizationForToday(ctx, d, masterKeyToBytes(group.groupMasterKey))
+	if err != nil {
+		return "", err
+	}
+	response, err := web.SendHTTPRequest("GET", "/v1/groups/avatar/form", &web.HTTPReqOpt{
+		Username:    &groupAuth.Username,
+		Password:    &groupAuth.Password,
+		ContentType: web.ContentTypeProtobuf,
+		Host:        web.StorageUrlHost,
+	})
+	if err != nil {
+		zlog.Err(err).Msg("uploadGroupAvatar form request error")
+		return "", err
+	}
+	if response.StatusCode != 200 {
+		err := fmt.Errorf("uploadGroupAvatar form request bad status: %v", response.StatusCode)
+		zlog.Err(err).Msg("")
+		return "", err
+	}
+	formBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		zlog.Err(err).Msg("uploadGroupAvatar form ReadAll error")
+		return "", err
+	}
+	form := &signalpb.AvatarUploadAttributes{}
+	err = proto.Unmarshal(formBytes, form)
+	if err != nil {
+		zlog.Err(err).Msg("uploadGroupAvatar form Unmarshal error")
+		return "", err
+	}
+
+	// Upload avatar to CDN
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	fields := [][2]string{
+		{"acl", form.Acl},
+		{"key", form.Key},
+		{"policy", form.Policy},
+		{"Content-Type", string(web.ContentTypeOctetStream)},
+		{"x-amz-algorithm", form.Algorithm},
+		{"x-amz-credential", form.Credential},
+		{"x-amz-date", form.Date},
+		{"x-amz-signature", form.Signature},
+	}
+	for _, field := range fields {
+		err = writer.WriteField(field[0], field[1])
+		if err != nil {
+			return "", err
+		}
+	}
+	file, err := writer.CreateFormFile("file", "file")
+	if err != nil {
+		return "", err
+	}
+	_, err = file.Write(encryptedAvatar)
+	if err != nil {
+		return "", err
+	}
+	err = writer.Close()
+	if err != nil {
+		return "", err
+	}
+	response, err = web.SendHTTPRequest("POST", "/", &web.HTTPReqOpt{
+		Body:        body.Bytes(),
+		ContentType: web.ContentType(writer.FormDataContentType()),
+		Host:        web.CDNUrlHost,
+	})
+	if err != nil {
+		zlog.Err(err).Msg("uploadGroupAvatar upload error")
+		return "", err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		err := fmt.Errorf("uploadGroupAvatar upload bad status: %v", response.StatusCode)
+		zlog.Err(err).Msg("")
+		return "", err
+	}
+	return form.Key, nil
+}
+
+// memberWithPresentation creates a group member from a profile key credential,
+// which the group server needs to add someone as a full member
+func memberWithPresentation(groupSecretParams libsignalgo.GroupSecretParams, credential *libsignalgo.ExpiringProfileKeyCredential, role signalpb.Member_Role) (*signalpb.Member, error) {
+	randomness, err := libsignalgo.GenerateRandomness()
+	if err != nil {
+		return nil, err
+	}
+	presentation, err := libsignalgo.CreateExpiringProfileKeyCredentialPresentation(serverPublicParams(), randomness, groupSecretParams, *credential)
+	if err != nil {
+		zlog.Err(err).Msg("CreateExpiringProfileKeyCredentialPresentation error")
+		return nil, err
+	}
+	return &signalpb.Member{
+		Role:         role,
+		Presentation: presentation,
+	}, nil
+}
+
+// CreateGroup creates a new group with us as its admin and returns its identifier. Members whose profile key
+// credential we can get are added directly, everyone else is invited. The avatar is optional.
+func CreateGroup(ctx context.Context, d *Device, title, description string, avatar []byte, expireTimer uint32, memberIDs []string) (GroupIdentifier, error) {
+	groupSecretParams, err := libsignalgo.GenerateGroupSecretParams()
+	if err != nil {
+		zlog.Err(err).Msg("GenerateGroupSecretParams error")
+		return "", err
+	}
+	masterKey, err := groupSecretParams.GetMasterKey()
+	if err != nil {
+		zlog.Err(err).Msg("GetMasterKey error")
+		return "", err
+	}
+	groupMasterKey := masterKeyFromBytes(*masterKey)
+	publicParams, err := groupSecretParams.GetPublicParams()
+	if err != nil {
+		zlog.Err(err).Msg("GetPublicParams error")
+		return "", err
+	}
+
+	ourProfile, err := RetrieveProfileByID(ctx, d, d.Data.AciUuid)
+	if err != nil {
+		return "", err
+	}
+	if ourProfile.Credential == nil {
+		return "", errors.New("didn't get a profile key credential for our own profile")
+	}
+	ourMember, err := memberWithPresentation(groupSecretParams, ourProfile.Credential, signalpb.Member_ADMINISTRATOR)
+	if err != nil {
+		return "", err
+	}
+	encryptedGroup := &signalpb.Group{
+		PublicKey: publicParams[:],
+		AccessControl: &signalpb.AccessControl{
+			Attributes:        signalpb.AccessControl_MEMBER,
+			Members:           signalpb.AccessControl_MEMBER,
+			AddFromInviteLink: signalpb.AccessControl_UNSATISFIABLE,
+		},
+		Revision: 0,
+		Members:  []*signalpb.Member{ourMember},
+	}
+	encryptedGroup.Title, err = encryptGroupAttributeBlob(groupSecretParams, &signalpb.GroupAttributeBlob{
+		Content: &signalpb.GroupAttributeBlob_Title{Title: title},
+	})
+	if err != nil {
+		return "", err
+	}
+	if description != "" {
+		encryptedGroup.Description, err = encryptGroupAttributeBlob(groupSecretParams, &signalpb.GroupAttributeBlob{
+			Content: &signalpb.GroupAttributeBlob_Description{Description: description},
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	if expireTimer > 0 {
+		encryptedGroup.DisappearingMessagesTimer, err = encryptGroupAttributeBlob(groupSecretParams, &signalpb.GroupAttributeBlob{
+			Content: &signalpb.GroupAttributeBlob_DisappearingMessagesDuration{DisappearingMessagesDuration: expireTimer},
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for _, memberID := range memberIDs {
+		if memberID == d.Data.AciUuid {
+			continue
+		}
+		profile, err := RetrieveProfileByID(ctx, d, memberID)
+		if err == nil && profile.Credential != nil {
+			member, err := memberWithPresentation(groupSecretParams, profile.Credential, signalpb.Member_DEFAULT)
+			if err == nil {
+				encryptedGroup.Members = append(encryptedGroup.Members, member)
+				continue
+			}
+		}
+		zlog.Debug().Msgf("No profile key credential for %s, inviting them to the new group instead", memberID)
+		encryptedUserID, err := encryptUserID(groupSecretParams, memberID)
+		if err != nil {
+			return "", err
+		}
+		encryptedGroup.PendingMembers = append(encryptedGroup.PendingMembers, &signalpb.PendingMember{
+			Member: &signalpb.Member{
+				UserId: encryptedUserID,
+				Role:   signalpb.Member_DEFAULT,
+			},
+		})
+	}
+
+	if len(avatar) > 0 {
+		encryptedGroup.Avatar, err = uploadGroupAvatar(ctx, d, &Group{groupMasterKey: groupMasterKey}, groupSecretParams, avatar)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	groupBytes, err := proto.Marshal(encryptedGroup)
+	if err != nil {
+		return "", err
+	}
+	groupAuth, err := GetAuthorizationForToday(ctx, d, *masterKey)
+	if err != nil {
+		return "", err
+	}
+	response, err := web.SendHTTPRequest("PUT", "/v1/groups/", &web.HTTPReqOpt{
+		Body:        groupBytes,
+		Username:    &groupAuth.Username,
+		Password:    &groupAuth.Password,
+		ContentType: web.ContentTypeProtobuf,
+		Host:        web.StorageUrlHost,
+	})
+	if err != nil {
+		zlog.Err(err).Msg("CreateGroup SendHTTPRequest error")
+		return "", err
+	}
+	if response.StatusCode != 200 {
+		err := fmt.Errorf("CreateGroup SendHTTPRequest bad status: %v", response.StatusCode)
+		zlog.Err(err).Msg("")
+		return "", err
+	}
+
+	gid, err := StoreMasterKey(ctx, d, groupMasterKey)
+	if err != nil {
+		return "", err
+	}
+
+	// Let the members that were added directly know about the group
+	group, err := RetrieveGroupByID(ctx, d, gid)
+	if err != nil {
+		zlog.Err(err).Msg("Failed to fetch newly created group")
+		return gid, nil
+	}
+	timestamp := currentMessageTimestamp()
+	dm := &signalpb.DataMessage{
+		Timestamp: &timestamp,
+		GroupV2:   groupMetadataForDataMessage(*group),
+	}
+	if expireTimer > 0 {
+		dm.ExpireTimer = proto.Uint32(expireTimer)
+	}
+	_, err = sendGroupDataMessage(ctx, d, group, (*signalpb.Content)(wrapDataMessageInContent(dm)))
+	if err != nil {
+		zlog.Err(err).Msg("Failed to send group update for newly created group")
+	}
+	if len(avatar) > 0 {
+		d.Connection.GroupCache.avatarPaths[gid] = group.AvatarPath
+	}
+	return gid, nil
+}
@@ -0,0 +1,262 @@
+package signalmeow
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"go.mau.fi/mautrix-signal/pkg/libsignalgo"
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+	"go.mau.fi/mautrix-signal/pkg/signalmeow/web"
+	"google.golang.org/protobuf/proto"
+)
+
+const groupInviteLinkPrefix = "https://signal.group/#"
+
+var ErrInviteLinkDisabled = errors.New("the invite link of the group is disabled")
+
+// GroupJoinInfo is the preview of a group that can be seen by anyone with its invite link
+type GroupJoinInfo struct {
+	GroupIdentifier   GroupIdentifier
+	Title             string
+	Description       string
+	AvatarPath        string
+	MemberCount       uint32
+	AddFromInviteLink AccessControl // ANY if anyone can join, ADMINISTRATOR if an admin has to approve
+	Revision          uint32
+	// We already asked to join and are waiting for an admin to approve
+	PendingAdminApproval bool
+
+	groupMasterKey     SerializedGroupMasterKey
+	inviteLinkPassword []byte
+}
+
+// ParseGroupInviteLink extracts the group master key and invite link password from a signal.group link
+func ParseGroupInviteLink(link string) (SerializedGroupMasterKey, []byte, error) {
+	link = strings.TrimSpace(link)
+	encodedContents, found := strings.CutPrefix(link, groupInviteLinkPrefix)
+	if !found {
+		encodedContents, found = strings.CutPrefix(link, "sgnl://signal.group/#")
+	}
+	if !found {
+		return "", nil, errors.New("not a signal.group link")
+	}
+	inviteLinkBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encodedContents, "="))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid invite link: %w", err)
+	}
+	inviteLink := &signalpb.GroupInviteLink{}
+	err = proto.Unmarshal(inviteLinkBytes, inviteLink)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid invite link: %w", err)
+	}
+	contents := inviteLink.GetV1Contents()
+	if contents == nil {
+		return "", nil, errors.New("unsupported invite link version")
+	}
+	var masterKey libsignalgo.GroupMasterKey
+	if len(contents.GroupMasterKey) != len(masterKey) || len(contents.InviteLinkPassword) == 0 {
+		return "", nil, errors.New("invalid invite link contents")
+	}
+	copy(masterKey[:], contents.GroupMasterKey)
+	return masterKeyFromBytes(masterKey), contents.InviteLinkPassword, nil
+}
+
+// GroupInviteLink returns the signal.group link of a group, or ErrInviteLinkDisabled if it can't be used
+func GroupInviteLink(group *Group) (string, error) {
+	if len(group.InviteLinkPassword) == 0 || group.AccessControl == nil ||
+		(group.AccessControl.AddFromInviteLink != AccessControl_ANY && group.AccessControl.AddFromInviteLink != AccessControl_ADMINISTRATOR) {
+		return "", ErrInviteLinkDisabled
+	}
+	masterKey := masterKeyToBytes(group.groupMasterKey)
+	inviteLinkBytes, err := proto.Marshal(&signalpb.GroupInviteLink{
+		Contents: &signalpb.GroupInviteLink_V1Contents{
+			V1Contents: &signalpb.GroupInviteLink_GroupInviteLinkContentsV1{
+				GroupMasterKey:     masterKey[:],
+				InviteLinkPassword: group.InviteLinkPassword,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return groupInviteLinkPrefix + base64.RawURLEncoding.EncodeToString(inviteLinkBytes), nil
+}
+
+// GetGroupInviteLink fetches the latest version of a group and returns its invite link
+func GetGroupInviteLink(ctx context.Context, d *Device, gid GroupIdentifier) (string, error) {
+	InvalidateGroupCache(d, gid)
+	group, err := RetrieveGroupByID(ctx, d, gid)
+	if err != nil {
+		return "", err
+	}
+	return GroupInviteLink(group)
+}
+
+// ResetGroupInviteLink replaces the invite link password of a group, so the old link stops working.
+// If the invite link was disabled, it's enabled so that anyone with the link can join.
+func ResetGroupInviteLink(ctx context.Context, d *Device, gid GroupIdentifier) (string, error) {
+	err := modifyGroup(ctx, d, gid, func(group *Group, groupSecretParams libsignalgo.GroupSecretParams) (*signalpb.GroupChange_Actions, error) {
+		password := make([]byte, 16)
+		_, err := rand.Read(password)
+		if err != nil {
+			return nil, err
+		}
+		actions := &signalpb.GroupChange_Actions{
+			ModifyInviteLinkPassword: &signalpb.GroupChange_Actions_ModifyInviteLinkPasswordAction{
+				InviteLinkPassword: password,
+			},
+		}
+		if _, err = GroupInviteLink(group); errors.Is(err, ErrInviteLinkDisabled) {
+			actions.ModifyAddFromInviteLinkAccess = &signalpb.GroupChange_Actions_ModifyAddFromInviteLinkAccessControlAction{
+				AddFromInviteLinkAccess: signalpb.AccessControl_ANY,
+			}
+		}
+		return actions, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return GetGroupInviteLink(ctx, d, gid)
+}
+
+// FetchGroupJoinInfo gets the preview of the group an invite link points to
+func FetchGroupJoinInfo(ctx context.Context, d *Device, link string) (*GroupJoinInfo, error) {
+	groupMasterKey, password, err := ParseGroupInviteLink(link)
+	if err != nil {
+		return nil, err
+	}
+	groupAuth, err := GetAuthorizationForToday(ctx, d, masterKeyToBytes(groupMasterKey))
+	if err != nil {
+		return nil, err
+	}
+	opts := &web.HTTPReqOpt{
+		Username:    &groupAuth.Username,
+		Password:    &groupAuth.Password,
+		ContentType: web.ContentTypeProtobuf,
+		Host:        web.StorageUrlHost,
+	}
+	response, err := web.SendHTTPRequest("GET", "/v1/groups/join/"+base64.RawURLEncoding.EncodeToString(password), opts)
+	if err != nil {
+		zlog.Err(err).Msg("FetchGroupJoinInfo SendHTTPRequest error")
+		return nil, err
+	}
+	if response.StatusCode == 403 {
+		return nil, ErrInviteLinkDisabled
+	} else if response.StatusCode != 200 {
+		err := fmt.Errorf("FetchGroupJoinInfo SendHTTPRequest bad status: %v", response.StatusCode)
+		zlog.Err(err).Msg("")
+		return nil, err
+	}
+	joinInfoBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		zlog.Err(err).Msg("FetchGroupJoinInfo ReadAll error")
+		return nil, err
+	}
+	encryptedJoinInfo := &signalpb.GroupJoinInfo{}
+	err = proto.Unmarshal(joinInfoBytes, encryptedJoinInfo)
+	if err != nil {
+		zlog.Err(err).Msg("FetchGroupJoinInfo Unmarshal error")
+		return nil, err
+	}
+
+	groupSecretParams, err := libsignalgo.DeriveGroupSecretParamsFromMasterKey(masterKeyToBytes(groupMasterKey))
+	if err != nil {
+		zlog.Err(err).Msg("DeriveGroupSecretParamsFromMasterKey error")
+		return nil, err
+	}
+	gid, err := groupIdentifierFromMasterKey(groupMasterKey)
+	if err != nil {
+		return nil, err
+	}
+	titleBlob, err := decryptGroupAttributeBlob(groupSecretParams, encryptedJoinInfo.Title)
+	if err != nil {
+		return nil, err
+	}
+	descriptionBlob, err := decryptGroupAttributeBlob(groupSecretParams, encryptedJoinInfo.Description)
+	if err != nil {
+		return nil, err
+	}
+	return &GroupJoinInfo{
+		GroupIdentifier:      gid,
+		Title:                titleBlob.GetTitle(),
+		Description:          descriptionBlob.GetDescription(),
+		AvatarPath:           encryptedJoinInfo.Avatar,
+		MemberCount:          encryptedJoinInfo.MemberCount,
+		AddFromInviteLink:    AccessControl(encryptedJoinInfo.AddFromInviteLink),
+		Revision:             encryptedJoinInfo.Revision,
+		PendingAdminApproval: encryptedJoinInfo.PendingAdminApproval,
+		groupMasterKey:       groupMasterKey,
+		inviteLinkPassword:   password,
+	}, nil
+}
+
+// JoinGroupWithInviteLink joins the group of a GroupJoinInfo, or asks to join it if an admin has to approve
+// new members. Returns true if we only asked to join.
+func JoinGroupWithInviteLink(ctx context.Context, d *Device, joinInfo *GroupJoinInfo) (requested bool, err error) {
+	if joinInfo.PendingAdminApproval {
+		return false, errors.New("already asked to join the group")
+	}
+	groupSecretParams, err := libsignalgo.DeriveGroupSecretParamsFromMasterKey(masterKeyToBytes(joinInfo.groupMasterKey))
+	if err != nil {
+		zlog.Err(err).Msg("DeriveGroupSecretParamsFromMasterKey error")
+		return false, err
+	}
+	ourProfile, err := RetrieveProfileByID(ctx, d, d.Data.AciUuid)
+	if err != nil {
+		return false, err
+	}
+	if ourProfile.Credential == nil {
+		return false, errors.New("didn't get a profile key credential for our own profile")
+	}
+	ourMember, err := memberWithPresentation(groupSecretParams, ourProfile.Credential, signalpb.Member_DEFAULT)
+	if err != nil {
+		return false, err
+	}
+
+	actions := &signalpb.GroupChange_Actions{Revision: joinInfo.Revision + 1}
+	switch joinInfo.AddFromInviteLink {
+	case AccessControl_ANY:
+		actions.AddMembers = []*signalpb.GroupChange_Actions_AddMemberAction{{
+			Added:              ourMember,
+			JoinFromInviteLink: true,
+		}}
+	case AccessControl_ADMINISTRATOR:
+		actions.AddRequestingMembers = []*signalpb.GroupChange_Actions_AddRequestingMemberAction{{
+			Added: &signalpb.RequestingMember{Presentation: ourMember.Presentation},
+		}}
+		requested = true
+	default:
+		return false, ErrInviteLinkDisabled
+	}
+	path := "/v1/groups/?inviteLinkPassword=" + url.QueryEscape(base64.RawURLEncoding.EncodeToString(joinInfo.inviteLinkPassword))
+	groupChange, err := sendGroupPatch(ctx, d, joinInfo.groupMasterKey, actions, path)
+	if err != nil {
+		return false, err
+	}
+	gid, err := StoreMasterKey(ctx, d, joinInfo.groupMasterKey)
+	if err != nil {
+		return false, err
+	}
+	if requested {
+		return true, nil
+	}
+
+	// Let the other members know we joined
+	InvalidateGroupCache(d, gid)
+	group, err := RetrieveGroupByID(ctx, d, gid)
+	if err != nil {
+		zlog.Err(err).Msg("Failed to fetch group after joining")
+		return false, nil
+	}
+	err = sendGroupChange(ctx, d, group, groupChange, group.DisappearingMessagesTimer)
+	if err != nil {
+		zlog.Err(err).Msg("Failed to send group change after joining")
+	}
+	return false, nil
+}
@@ -17,6 +17,7 @@ type dbGroup struct {
 type GroupStore interface {
 	MasterKeyFromGroupIdentifier(groupIdentifier GroupIdentifier, ctx context.Context) (SerializedGroupMasterKey, error)
 	StoreMasterKey(groupIdentifier GroupIdentifier, key SerializedGroupMasterKey, ctx context.Context) error
+	AllGroupIdentifiers(ctx context.Context) ([]GroupIdentifier, error)
 }
 
 func scanGroup(row scannable) (*dbGroup, error) {
@@ -64,3 +65,22 @@ func (s *SQLStore) StoreMasterKey(groupIdentifier GroupIdentifier, key Serialize
 	err = tx.Commit()
 	return err
 }
+
+func (s *SQLStore) AllGroupIdentifiers(ctx context.Context) ([]GroupIdentifier, error) {
+	allGroupsQuery := `SELECT group_identifier FROM signalmeow_groups WHERE our_aci_uuid=$1`
+	rows, err := s.db.QueryContext(ctx, allGroupsQuery, s.AciUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var groupIdentifiers []GroupIdentifier
+	for rows.Next() {
+		var groupIdentifier GroupIdentifier
+		err = rows.Scan(&groupIdentifier)
+		if err != nil {
+			return nil, err
+		}
+		groupIdentifiers = append(groupIdentifiers, groupIdentifier)
+	}
+	return groupIdentifiers, rows.Err()
+}
@@ -34,6 +34,42 @@ type GroupMember struct {
 	JoinedAtRevision uint32
 	//Presentation     []byte
 }
+type PendingMember struct {
+	UserId        string // Can be an ACI or a PNI
+	Role          GroupMemberRole
+	AddedByUserId string
+	Timestamp     uint64
+}
+
+type RequestingMember struct {
+	UserId     string
+	ProfileKey libsignalgo.ProfileKey
+	Timestamp  uint64
+}
+
+type BannedMember struct {
+	UserId    string
+	Timestamp uint64
+}
+
+type AccessControl int32
+
+const (
+	// Note: right now we assume these match the equivalent values in the protobuf (signalpb.AccessControl_AccessRequired)
+	AccessControl_UNKNOWN       AccessControl = 0
+	AccessControl_ANY           AccessControl = 1
+	AccessControl_MEMBER        AccessControl = 2
+	AccessControl_ADMINISTRATOR AccessControl = 3
+	AccessControl_UNSATISFIABLE AccessControl = 4
+)
+
+// GroupAccessControl is who is allowed to do what in a group
+type GroupAccessControl struct {
+	Attributes        AccessControl // Changing the title, avatar, description and timer
+	Members           AccessControl // Adding members
+	AddFromInviteLink AccessControl // Joining with the invite link (ANY, or ADMINISTRATOR if an admin needs to approve)
+}
+
 type Group struct {
 	groupMasterKey  SerializedGroupMasterKey // We should keep this relatively private
 	GroupIdentifier GroupIdentifier          // This is what we should use to identify a group outside this file
@@ -44,13 +80,15 @@ type Group struct {
 	Description       string
 	AnnouncementsOnly bool
 	Revision          uint32
+	AccessControl     *GroupAccessControl
+	PendingMembers    []*PendingMember    // Invited, but haven't accepted yet
+	RequestingMembers []*RequestingMember // Asked to join with the invite link, waiting for an admin to approve
+	BannedMembers     []*BannedMember
+	// The password part of the invite link, or nil if the link was never enabled.
+	// Whether it can be used depends on AccessControl.AddFromInviteLink.
+	InviteLinkPassword        []byte
+	DisappearingMessagesTimer uint32 // In seconds, 0 means messages don't disappear
 	//PublicKey                 *libsignalgo.PublicKey
-	//DisappearingMessagesTimer []byte
-	//AccessControl             *AccessControl
-	//PendingMembers            []*PendingMember
-	//RequestingMembers         []*RequestingMember
-	//InviteLinkPassword        []byte
-	//BannedMembers             []*BannedMember
 }
 
 type GroupAuth struct {
@@ -245,12 +283,12 @@ func decryptGroup(encryptedGroup *signalpb.Group, groupMasterKey SerializedGroup
 	}
 	decryptedGroup.GroupIdentifier = gid
 
-	title, err := groupSecretParams.DecryptBlobWithPadding(encryptedGroup.Title)
+	titleBlob, err := decryptGroupAttributeBlob(groupSecretParams, encryptedGroup.Title)
 	if err != nil {
 		zlog.Err(err).Msg("DecryptBlobWithPadding Title error")
 		return nil, err
 	}
-	titleString := string(title)
+	titleString := titleBlob.GetTitle()
 	// strip non-printable characters from the title
 	titleString = strings.Map(func(r rune) rune {
 		if unicode.IsGraphic(r) {
@@ -262,9 +300,25 @@ func decryptGroup(encryptedGroup *signalpb.Group, groupMasterKey SerializedGroup
 	titleString = strings.TrimSpace(titleString)
 	decryptedGroup.Title = titleString
 
+	descriptionBlob, err := decryptGroupAttributeBlob(groupSecretParams, encryptedGroup.Description)
+	if err != nil {
+		zlog.Err(err).Msg("DecryptBlobWithPadding Description error")
+		return nil, err
+	}
+	decryptedGroup.Description = descriptionBlob.GetDescription()
+
+	timerBlob, err := decryptGroupAttributeBlob(groupSecretParams, encryptedGroup.DisappearingMessagesTimer)
+	if err != nil {
+		zlog.Err(err).Msg("DecryptBlobWithPadding DisappearingMessagesTimer error")
+		return nil, err
+	}
+	decryptedGroup.DisappearingMessagesTimer = timerBlob.GetDisappearingMessagesDuration()
+
 	// These aren't encrypted
 	decryptedGroup.AvatarPath = encryptedGroup.Avatar
 	decryptedGroup.Revision = encryptedGroup.Revision
+	decryptedGroup.AnnouncementsOnly = encryptedGroup.AnnouncementsOnly
+	decryptedGroup.InviteLinkPassword = encryptedGroup.InviteLinkPassword
 
 	// Decrypt members
 	decryptedGroup.Members = make([]*GroupMember, 0)
@@ -272,29 +326,172 @@ func decryptGroup(encryptedGroup *signalpb.Group, groupMasterKey SerializedGroup
 		if member == nil {
 			continue
 		}
-		encryptedUserID := libsignalgo.UUIDCiphertext(member.UserId)
-		userID, err := groupSecretParams.DecryptUUID(encryptedUserID)
+		decryptedMember, err := decryptMember(groupSecretParams, member)
+		if err != nil {
+			return nil, err
+		}
+		decryptedGroup.Members = append(decryptedGroup.Members, decryptedMember)
+	}
+
+	decryptedGroup.PendingMembers = make([]*PendingMember, 0)
+	for _, pendingMember := range encryptedGroup.PendingMembers {
+		if pendingMember == nil {
+			continue
+		}
+		decryptedPendingMember, err := decryptPendingMember(groupSecretParams, pendingMember)
+		if err != nil {
+			return nil, err
+		}
+		decryptedGroup.PendingMembers = append(decryptedGroup.PendingMembers, decryptedPendingMember)
+	}
+
+	decryptedGroup.RequestingMembers = make([]*RequestingMember, 0)
+	for _, requestingMember := range encryptedGroup.RequestingMembers {
+		if requestingMember == nil {
+			continue
+		}
+		decryptedRequestingMember, err := decryptRequestingMember(groupSecretParams, requestingMember)
 		if err != nil {
-			zlog.Err(err).Msg("DecryptUUID UserId error")
 			return nil, err
 		}
-		encryptedProfileKey := libsignalgo.ProfileKeyCiphertext(member.ProfileKey)
-		profileKey, err := groupSecretParams.DecryptProfileKey(encryptedProfileKey, *userID)
+		decryptedGroup.RequestingMembers = append(decryptedGroup.RequestingMembers, decryptedRequestingMember)
+	}
+
+	decryptedGroup.BannedMembers = make([]*BannedMember, 0)
+	for _, bannedMember := range encryptedGroup.BannedMembers {
+		if bannedMember == nil {
+			continue
+		}
+		decryptedBannedMember, err := decryptBannedMember(groupSecretParams, bannedMember)
 		if err != nil {
-			zlog.Err(err).Msg("DecryptProfileKey ProfileKey error")
 			return nil, err
 		}
-		decryptedGroup.Members = append(decryptedGroup.Members, &GroupMember{
-			UserId:           convertByteUUIDToUUID(*userID),
-			ProfileKey:       *profileKey,
-			Role:             GroupMemberRole(member.Role),
-			JoinedAtRevision: member.JoinedAtRevision,
-		})
+		decryptedGroup.BannedMembers = append(decryptedGroup.BannedMembers, decryptedBannedMember)
+	}
+
+	if encryptedGroup.AccessControl != nil {
+		decryptedGroup.AccessControl = &GroupAccessControl{
+			Attributes:        AccessControl(encryptedGroup.AccessControl.Attributes),
+			Members:           AccessControl(encryptedGroup.AccessControl.Members),
+			AddFromInviteLink: AccessControl(encryptedGroup.AccessControl.AddFromInviteLink),
+		}
 	}
 
 	return decryptedGroup, nil
 }
 
+func decryptUserID(groupSecretParams libsignalgo.GroupSecretParams, encryptedUserID []byte) (string, error) {
+	userID, err := groupSecretParams.DecryptUUID(libsignalgo.UUIDCiphertext(encryptedUserID))
+	if err != nil {
+		zlog.Err(err).Msg("DecryptUUID UserId error")
+		return "", err
+	}
+	return convertByteUUIDToUUID(*userID), nil
+}
+
+func encryptUserID(groupSecretParams libsignalgo.GroupSecretParams, userID string) ([]byte, error) {
+	uuid, err := convertUUIDToByteUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	encryptedUserID, err := groupSecretParams.EncryptUUID(*uuid)
+	if err != nil {
+		zlog.Err(err).Msg("EncryptUUID UserId error")
+		return nil, err
+	}
+	return encryptedUserID[:], nil
+}
+
+func decryptUserIDAndProfileKey(groupSecretParams libsignalgo.GroupSecretParams, encryptedUserID, encryptedProfileKey []byte) (string, *libsignalgo.ProfileKey, error) {
+	userID, err := groupSecretParams.DecryptUUID(libsignalgo.UUIDCiphertext(encryptedUserID))
+	if err != nil {
+		zlog.Err(err).Msg("DecryptUUID UserId error")
+		return "", nil, err
+	}
+	profileKey, err := groupSecretParams.DecryptProfileKey(libsignalgo.ProfileKeyCiphertext(encryptedProfileKey), *userID)
+	if err != nil {
+		zlog.Err(err).Msg("DecryptProfileKey ProfileKey error")
+		return "", nil, err
+	}
+	return convertByteUUIDToUUID(*userID), profileKey, nil
+}
+
+func decryptMember(groupSecretParams libsignalgo.GroupSecretParams, member *signalpb.Member) (*GroupMember, error) {
+	userID, profileKey, err := decryptUserIDAndProfileKey(groupSecretParams, member.UserId, member.ProfileKey)
+	if err != nil {
+		return nil, err
+	}
+	return &GroupMember{
+		UserId:           userID,
+		ProfileKey:       *profileKey,
+		Role:             GroupMemberRole(member.Role),
+		JoinedAtRevision: member.JoinedAtRevision,
+	}, nil
+}
+
+func decryptPendingMember(groupSecretParams libsignalgo.GroupSecretParams, pendingMember *signalpb.PendingMember) (*PendingMember, error) {
+	userID, err := decryptUserID(groupSecretParams, pendingMember.GetMember().GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	// Who sent the invite is just informational, so a missing or broken inviter shouldn't make the whole group unusable
+	var addedBy string
+	if len(pendingMember.AddedByUserId) == 0 {
+		zlog.Warn().Msgf("Pending member %s has no inviter", userID)
+	} else if addedBy, err = decryptUserID(groupSecretParams, pendingMember.AddedByUserId); err != nil {
+		zlog.Warn().Err(err).Msgf("Failed to decrypt inviter of pending member %s", userID)
+	}
+	return &PendingMember{
+		UserId:        userID,
+		Role:          GroupMemberRole(pendingMember.GetMember().GetRole()),
+		AddedByUserId: addedBy,
+		Timestamp:     pendingMember.Timestamp,
+	}, nil
+}
+
+func decryptRequestingMember(groupSecretParams libsignalgo.GroupSecretParams, requestingMember *signalpb.RequestingMember) (*RequestingMember, error) {
+	userID, profileKey, err := decryptUserIDAndProfileKey(groupSecretParams, requestingMember.UserId, requestingMember.ProfileKey)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestingMember{
+		UserId:     userID,
+		ProfileKey: *profileKey,
+		Timestamp:  requestingMember.Timestamp,
+	}, nil
+}
+
+func decryptBannedMember(groupSecretParams libsignalgo.GroupSecretParams, bannedMember *signalpb.BannedMember) (*BannedMember, error) {
+	userID, err := decryptUserID(groupSecretParams, bannedMember.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &BannedMember{
+		UserId:    userID,
+		Timestamp: bannedMember.Timestamp,
+	}, nil
+}
+
+// decryptGroupAttributeBlob decrypts a title, description or disappearing message timer.
+// An empty blob means the attribute was cleared, so an empty GroupAttributeBlob is returned.
+func decryptGroupAttributeBlob(groupSecretParams libsignalgo.GroupSecretParams, encryptedBlob []byte) (*signalpb.GroupAttributeBlob, error) {
+	blob := &signalpb.GroupAttributeBlob{}
+	if len(encryptedBlob) == 0 {
+		return blob, nil
+	}
+	plaintext, err := groupSecretParams.DecryptBlobWithPadding(encryptedBlob)
+	if err != nil {
+		zlog.Err(err).Msg("DecryptBlobWithPadding error")
+		return nil, err
+	}
+	err = proto.Unmarshal(plaintext, blob)
+	if err != nil {
+		zlog.Err(err).Msg("GroupAttributeBlob Unmarshal error")
+		return nil, err
+	}
+	return blob, nil
+}
+
 func decryptGroupAvatar(encryptedAvatar []byte, groupMasterKey SerializedGroupMasterKey) ([]byte, error) {
 	groupSecretParams, err := libsignalgo.DeriveGroupSecretParamsFromMasterKey(masterKeyToBytes(groupMasterKey))
 	if err != nil {
@@ -509,6 +706,12 @@ func StoreMasterKey(ctx context.Context, d *Device, groupMasterKey SerializedGro
 	return groupIdentifier, nil
 }
 
+// KnownGroupIdentifiers returns the groups we have the master key of, which are the groups
+// we've received a message or group change from. Use RetrieveGroupByID to check that we're still a member.
+func KnownGroupIdentifiers(ctx context.Context, d *Device) ([]GroupIdentifier, error) {
+	return d.GroupStore.AllGroupIdentifiers(ctx)
+}
+
 // We need to track active calls so we don't send too many IncomingSignalMessageCalls
 // Of course for group calls Signal doesn't tell us *anything* so we're mostly just inferring
 // So we just jam a new call ID in, and return true if we *think* this is a new incoming call
@@ -10,11 +10,19 @@ import (
 )
 
 var _ libsignalgo.IdentityKeyStore = (*SQLStore)(nil)
+var _ IdentityStoreExtras = (*SQLStore)(nil)
+
+type IdentityStoreExtras interface {
+	// SetIdentityVerified marks the identity key of the given UUID as verified or unverified.
+	// Nothing changes if the key isn't the one we have stored.
+	SetIdentityVerified(theirUuid string, identityKey []byte, verified bool, ctx context.Context) error
+}
 
 const (
 	getIdentityKeyPairQuery       = `SELECT aci_identity_key_pair FROM signalmeow_device WHERE aci_uuid=$1`
 	getRegistrationLocalIDQuery   = `SELECT registration_id FROM signalmeow_device WHERE aci_uuid=$1`
-	insertIdentityKeyQuery        = `INSERT INTO signalmeow_identity_keys (our_aci_uuid, their_aci_uuid, their_device_id, key, trust_level) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (our_aci_uuid, their_aci_uuid, their_device_id) DO UPDATE SET key=excluded.key, trust_level=excluded.trust_level`
+	insertIdentityKeyQuery        = `INSERT INTO signalmeow_identity_keys (our_aci_uuid, their_aci_uuid, their_device_id, key, trust_level) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (our_aci_uuid, their_aci_uuid, their_device_id) DO UPDATE SET key=excluded.key, trust_level=CASE WHEN signalmeow_identity_keys.key=excluded.key THEN signalmeow_identity_keys.trust_level ELSE excluded.trust_level END`
+	updateIdentityTrustLevelQuery = `UPDATE signalmeow_identity_keys SET trust_level=$4 WHERE our_aci_uuid=$1 AND their_aci_uuid=$2 AND key=$3`
 	getIdentityKeyTrustLevelQuery = `SELECT trust_level FROM signalmeow_identity_keys WHERE our_aci_uuid=$1 AND their_aci_uuid=$2 AND their_device_id=$3`
 	getIdentityKeyQuery           = `SELECT key FROM signalmeow_identity_keys WHERE our_aci_uuid=$1 AND their_aci_uuid=$2 AND their_device_id=$3`
 )
@@ -67,6 +75,7 @@ func (s *SQLStore) GetLocalRegistrationID(ctx context.Context) (uint32, error) {
 }
 
 func (s *SQLStore) SaveIdentityKey(address *libsignalgo.Address, identityKey *libsignalgo.IdentityKey, ctx context.Context) (bool, error) {
+	// An existing trust level is kept if the key didn't change
 	trustLevel := "TRUSTED_UNVERIFIED" // TODO: this should be hard coded here
 	serialized, err := identityKey.Serialize()
 	if err != nil {
@@ -157,3 +166,12 @@ func (s *SQLStore) GetIdentityKey(address *libsignalgo.Address, ctx context.Cont
 	}
 	return key, err
 }
+
+func (s *SQLStore) SetIdentityVerified(theirUuid string, identityKey []byte, verified bool, ctx context.Context) error {
+	trustLevel := "TRUSTED_UNVERIFIED"
+	if verified {
+		trustLevel = "TRUSTED_VERIFIED"
+	}
+	_, err := s.db.Exec(updateIdentityTrustLevelQuery, s.AciUuid, theirUuid, identityKey, trustLevel)
+	return err
+}
@@ -1,5 +1,9 @@
 package signalmeow
 
+import (
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+)
+
 // Below is a lot of boilerplate to have a nice ADTish type for incoming Signal messages
 
 type IncomingSignalMessageBase struct {
@@ -10,6 +14,11 @@ type IncomingSignalMessageBase struct {
 	Timestamp     uint64                             // With SenderUUID, treated as a unique identifier for a specific Signal message
 	Quote         *IncomingSignalMessageQuoteData    // If this message is a quote (reply), this will be non-nil
 	Mentions      []IncomingSignalMessageMentionData // If this message mentions other users, this will be len > 0
+	Styles        []StyleRange                       // Formatting of the text of the message, like bold or spoilers
+	ExpiresIn     uint32                             // Disappearing message timer in seconds, 0 if the message doesn't disappear
+	PartIndex     int                                // Which part this is when one Signal message is split into several, like the attachments of an album
+	ViewOnce      bool                               // The attachment of this message should be removed once it has been viewed
+	StoryContext  *IncomingSignalMessageStoryContext // If this message is a reply or reaction to a story, this will be non-nil
 }
 
 type IncomingSignalMessageQuoteData struct {
@@ -17,6 +26,11 @@ type IncomingSignalMessageQuoteData struct {
 	QuotedSender    string
 }
 
+type IncomingSignalMessageStoryContext struct {
+	StoryAuthorUUID string
+	StoryTimestamp  uint64
+}
+
 type IncomingSignalMessageMentionData struct {
 	Start         uint32
 	Length        uint32
@@ -24,6 +38,22 @@ type IncomingSignalMessageMentionData struct {
 	MentionedName string
 }
 
+// StyleRange is a formatted part of a message body, Start and Length are in UTF-16 code units
+type StyleRange struct {
+	Start  uint32
+	Length uint32
+	Style  signalpb.BodyRange_Style
+}
+
+// IncomingSignalMessagePreview is a link preview attached to a text message
+type IncomingSignalMessagePreview struct {
+	URL         string
+	Title       string
+	Description string
+	Date        uint64             // When the linked page was published, in milliseconds, 0 if unknown
+	Image       *AttachmentPointer // Download with DownloadAttachment, nil if the preview has no image
+}
+
 type IncomingSignalMessageType int
 
 const (
@@ -36,6 +66,25 @@ const (
 	IncomingSignalMessageTypeReceipt
 	IncomingSignalMessageTypeSticker
 	IncomingSignalMessageTypeCall
+	IncomingSignalMessageTypeExpireTimer
+	IncomingSignalMessageTypeGroupChange
+	IncomingSignalMessageTypeVideo
+	IncomingSignalMessageTypeAudio
+	IncomingSignalMessageTypeVoiceNote
+	IncomingSignalMessageTypeFile
+	IncomingSignalMessageTypeEdit
+	IncomingSignalMessageTypeContact
+	IncomingSignalMessageTypeStory
+	IncomingSignalMessageTypeViewOnceOpen
+	IncomingSignalMessageTypeBlocked
+	IncomingSignalMessageTypeConfiguration
+	IncomingSignalMessageTypeStickerPackOperation
+	IncomingSignalMessageTypeFetchLatest
+	IncomingSignalMessageTypeKeys
+	IncomingSignalMessageTypeMessageRequestResponse
+	IncomingSignalMessageTypeCallEvent
+	IncomingSignalMessageTypePniChangeNumber
+	IncomingSignalMessageTypeContactSync
 )
 
 type IncomingSignalMessage interface {
@@ -53,6 +102,25 @@ var _ IncomingSignalMessage = IncomingSignalMessageTyping{}
 var _ IncomingSignalMessage = IncomingSignalMessageReceipt{}
 var _ IncomingSignalMessage = IncomingSignalMessageSticker{}
 var _ IncomingSignalMessage = IncomingSignalMessageCall{}
+var _ IncomingSignalMessage = IncomingSignalMessageExpireTimer{}
+var _ IncomingSignalMessage = IncomingSignalMessageGroupChange{}
+var _ IncomingSignalMessage = IncomingSignalMessageVideo{}
+var _ IncomingSignalMessage = IncomingSignalMessageAudio{}
+var _ IncomingSignalMessage = IncomingSignalMessageVoiceNote{}
+var _ IncomingSignalMessage = IncomingSignalMessageFile{}
+var _ IncomingSignalMessage = IncomingSignalMessageEdit{}
+var _ IncomingSignalMessage = IncomingSignalMessageContact{}
+var _ IncomingSignalMessage = IncomingSignalMessageStory{}
+var _ IncomingSignalMessage = IncomingSignalMessageViewOnceOpen{}
+var _ IncomingSignalMessage = IncomingSignalMessageBlocked{}
+var _ IncomingSignalMessage = IncomingSignalMessageConfiguration{}
+var _ IncomingSignalMessage = IncomingSignalMessageStickerPackOperation{}
+var _ IncomingSignalMessage = IncomingSignalMessageFetchLatest{}
+var _ IncomingSignalMessage = IncomingSignalMessageKeys{}
+var _ IncomingSignalMessage = IncomingSignalMessageMessageRequestResponse{}
+var _ IncomingSignalMessage = IncomingSignalMessageCallEvent{}
+var _ IncomingSignalMessage = IncomingSignalMessagePniChangeNumber{}
+var _ IncomingSignalMessage = IncomingSignalMessageContactSync{}
 
 // ** IncomingSignalMessageUnhandled **
 type IncomingSignalMessageUnhandled struct {
@@ -71,7 +139,8 @@ func (i IncomingSignalMessageUnhandled) Base() IncomingSignalMessageBase {
 // ** IncomingSignalMessageText **
 type IncomingSignalMessageText struct {
 	IncomingSignalMessageBase
-	Content string
+	Content  string
+	Previews []IncomingSignalMessagePreview
 }
 
 func (IncomingSignalMessageText) MessageType() IncomingSignalMessageType {
@@ -85,7 +154,7 @@ func (i IncomingSignalMessageText) Base() IncomingSignalMessageBase {
 type IncomingSignalMessageImage struct {
 	IncomingSignalMessageBase
 	Caption     string
-	Image       []byte
+	Attachment  *AttachmentPointer // Download with DownloadAttachment
 	Filename    string
 	ContentType string
 	Size        uint64
@@ -101,6 +170,80 @@ func (i IncomingSignalMessageImage) Base() IncomingSignalMessageBase {
 	return i.IncomingSignalMessageBase
 }
 
+// ** IncomingSignalMessageVideo **
+type IncomingSignalMessageVideo struct {
+	IncomingSignalMessageBase
+	Caption     string
+	Attachment  *AttachmentPointer
+	Filename    string
+	ContentType string
+	Size        uint64
+	Width       uint32
+	Height      uint32
+	BlurHash    string
+	Thumbnail   []byte // Inline thumbnail, usually empty
+	GIF         bool   // Sent as a GIF, should be looped and played without sound
+}
+
+func (IncomingSignalMessageVideo) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeVideo
+}
+func (i IncomingSignalMessageVideo) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageAudio **
+type IncomingSignalMessageAudio struct {
+	IncomingSignalMessageBase
+	Caption     string
+	Attachment  *AttachmentPointer
+	Filename    string
+	ContentType string
+	Size        uint64
+}
+
+func (IncomingSignalMessageAudio) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeAudio
+}
+func (i IncomingSignalMessageAudio) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageVoiceNote **
+// An audio attachment with the VOICE_MESSAGE flag, recorded in the Signal app
+type IncomingSignalMessageVoiceNote struct {
+	IncomingSignalMessageBase
+	Attachment  *AttachmentPointer
+	Filename    string
+	ContentType string
+	Size        uint64
+}
+
+func (IncomingSignalMessageVoiceNote) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeVoiceNote
+}
+func (i IncomingSignalMessageVoiceNote) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageFile **
+type IncomingSignalMessageFile struct {
+	IncomingSignalMessageBase
+	Caption     string
+	Attachment  *AttachmentPointer
+	Filename    string
+	ContentType string
+	Size        uint64
+	Thumbnail   []byte // Inline thumbnail, usually empty
+}
+
+func (IncomingSignalMessageFile) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeFile
+}
+func (i IncomingSignalMessageFile) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
 // ** IncomingSignalMessageSticker
 type IncomingSignalMessageSticker struct {
 	IncomingSignalMessageBase
@@ -181,6 +324,7 @@ type IncomingSignalMessageReceiptType int
 const (
 	IncomingSignalMessageReceiptTypeDelivery IncomingSignalMessageReceiptType = iota
 	IncomingSignalMessageReceiptTypeRead
+	IncomingSignalMessageReceiptTypeViewed // The media or story was opened, which also means it was read
 )
 
 type IncomingSignalMessageReceipt struct {
@@ -196,3 +340,282 @@ func (IncomingSignalMessageReceipt) MessageType() IncomingSignalMessageType {
 func (i IncomingSignalMessageReceipt) Base() IncomingSignalMessageBase {
 	return i.IncomingSignalMessageBase
 }
+
+// ** IncomingSignalMessageExpireTimer **
+type IncomingSignalMessageExpireTimer struct {
+	IncomingSignalMessageBase
+	NewExpireTimer uint32 // In seconds, 0 means disappearing messages were turned off
+}
+
+func (IncomingSignalMessageExpireTimer) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeExpireTimer
+}
+func (i IncomingSignalMessageExpireTimer) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageGroupChange **
+type IncomingSignalMessageGroupChange struct {
+	IncomingSignalMessageBase
+	GroupChange *GroupChange
+}
+
+func (IncomingSignalMessageGroupChange) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeGroupChange
+}
+func (i IncomingSignalMessageGroupChange) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageEdit **
+// The new text of a message. PartIndex is the part of the original message that has the text.
+// TargetMessageTimestamp is the timestamp of the previous edit if the message was already edited.
+type IncomingSignalMessageEdit struct {
+	IncomingSignalMessageBase
+	TargetMessageTimestamp uint64
+	Content                string
+	Caption                bool // The text is the caption of the attachment in PartIndex
+}
+
+func (IncomingSignalMessageEdit) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeEdit
+}
+func (i IncomingSignalMessageEdit) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageContact **
+// A contact card shared from the address book of the sender
+type IncomingSignalMessageContact struct {
+	IncomingSignalMessageBase
+	Contact *signalpb.DataMessage_Contact // Name, phone numbers, emails and addresses
+	Avatar  *AttachmentPointer            // Download with DownloadAttachment, nil if the contact has no avatar
+}
+
+func (IncomingSignalMessageContact) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeContact
+}
+func (i IncomingSignalMessageContact) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageStory **
+// A story posted by a contact (or by us on another device), which is either a file or styled text.
+// GroupID is set for stories posted to a group.
+type IncomingSignalMessageStory struct {
+	IncomingSignalMessageBase
+	AllowsReplies  bool
+	Caption        string             // Only for file stories, Mentions and Styles apply to it
+	Attachment     *AttachmentPointer // Download with DownloadAttachment, nil for text stories
+	Filename       string
+	ContentType    string
+	Size           uint64
+	Width          uint32
+	Height         uint32
+	TextAttachment *StoryTextAttachment // nil for file stories
+}
+
+func (IncomingSignalMessageStory) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeStory
+}
+func (i IncomingSignalMessageStory) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// StoryTextAttachment is the content of a text story. Colors are ARGB.
+type StoryTextAttachment struct {
+	Text            string
+	TextStyle       signalpb.TextAttachment_Style
+	ForegroundColor uint32
+	BackgroundColor uint32 // The highlight behind the text, 0 if there is none
+	Color           uint32 // The solid background of the story, only used if Gradient is nil
+	Gradient        *StoryGradient
+	Preview         *IncomingSignalMessagePreview // A link preview shown below the text, nil if there isn't one
+}
+
+// StoryGradient is the gradient background of a text story. Positions are
+// between 0 and 1, and the angle is in degrees.
+type StoryGradient struct {
+	Colors    []uint32
+	Positions []float32
+	Angle     uint32
+}
+
+// The messages below are sync messages from our other devices, telling us about things
+// that happened on the account. SenderUUID and RecipientUUID are our UUID unless noted otherwise.
+
+// ** IncomingSignalMessageViewOnceOpen **
+// View-once media was opened on another device, so it should be removed everywhere
+type IncomingSignalMessageViewOnceOpen struct {
+	IncomingSignalMessageBase
+	TargetAuthorUUID       string
+	TargetMessageTimestamp uint64
+}
+
+func (IncomingSignalMessageViewOnceOpen) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeViewOnceOpen
+}
+func (i IncomingSignalMessageViewOnceOpen) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageBlocked **
+// The full list of blocked contacts and groups, sent whenever it changes
+type IncomingSignalMessageBlocked struct {
+	IncomingSignalMessageBase
+	BlockedUUIDs    []string
+	BlockedGroupIDs []GroupIdentifier
+}
+
+func (IncomingSignalMessageBlocked) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeBlocked
+}
+func (i IncomingSignalMessageBlocked) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageConfiguration **
+// The privacy settings of the account
+type IncomingSignalMessageConfiguration struct {
+	IncomingSignalMessageBase
+	ReadReceipts                   bool
+	TypingIndicators               bool
+	LinkPreviews                   bool
+	UnidentifiedDeliveryIndicators bool
+}
+
+func (IncomingSignalMessageConfiguration) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeConfiguration
+}
+func (i IncomingSignalMessageConfiguration) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageStickerPackOperation **
+type IncomingSignalMessageStickerPackOperation struct {
+	IncomingSignalMessageBase
+	PackID  []byte
+	PackKey []byte
+	Remove  bool // The pack was uninstalled, otherwise it was installed
+}
+
+func (IncomingSignalMessageStickerPackOperation) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeStickerPackOperation
+}
+func (i IncomingSignalMessageStickerPackOperation) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageFetchLatest **
+// Something changed on the server, like our own profile, and should be fetched again
+type IncomingSignalMessageFetchLatest struct {
+	IncomingSignalMessageBase
+	FetchType signalpb.SyncMessage_FetchLatest_Type
+}
+
+func (IncomingSignalMessageFetchLatest) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeFetchLatest
+}
+func (i IncomingSignalMessageFetchLatest) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageKeys **
+type IncomingSignalMessageKeys struct {
+	IncomingSignalMessageBase
+	StorageServiceKey []byte
+}
+
+func (IncomingSignalMessageKeys) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeKeys
+}
+func (i IncomingSignalMessageKeys) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageMessageRequestResponse **
+// A message request was answered on another device. RecipientUUID is the chat the request was for,
+// and GroupID is set if it was a group.
+type IncomingSignalMessageMessageRequestResponse struct {
+	IncomingSignalMessageBase
+	ResponseType signalpb.SyncMessage_MessageRequestResponse_Type
+}
+
+func (IncomingSignalMessageMessageRequestResponse) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeMessageRequestResponse
+}
+func (i IncomingSignalMessageMessageRequestResponse) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageCallEvent **
+// A 1:1 call was answered, declined, started or deleted on another device. RecipientUUID is the other side of the call.
+type IncomingSignalMessageCallEvent struct {
+	IncomingSignalMessageBase
+	CallID   uint64
+	Video    bool
+	Outgoing bool
+	Event    CallEventType
+}
+
+// CallEventType matches the values of SyncMessage.CallEvent.Event, including the ones our protobuf doesn't know yet
+type CallEventType int
+
+const (
+	CallEventTypeUnknown CallEventType = iota
+	CallEventTypeAccepted
+	CallEventTypeNotAccepted
+	CallEventTypeDelete
+)
+
+func (IncomingSignalMessageCallEvent) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeCallEvent
+}
+func (i IncomingSignalMessageCallEvent) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessagePniChangeNumber **
+// The phone number of the account changed, which comes with a new PNI identity. Apply it with ApplyPniChangeNumber.
+type IncomingSignalMessagePniChangeNumber struct {
+	IncomingSignalMessageBase
+	IdentityKeyPair []byte // Serialized libsignal IdentityKeyPair
+	SignedPreKey    []byte // Serialized libsignal SignedPreKeyRecord
+	RegistrationID  uint32
+	UpdatedPni      string // The new PNI of the account, from the envelope
+}
+
+func (IncomingSignalMessagePniChangeNumber) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypePniChangeNumber
+}
+func (i IncomingSignalMessagePniChangeNumber) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
+
+// ** IncomingSignalMessageContactSync **
+// The contact list of the primary device, sent after linking and when it's requested with a contacts sync.
+// Profile keys and verification are saved before this is passed on.
+type IncomingSignalMessageContactSync struct {
+	IncomingSignalMessageBase
+	Contacts []SyncedContact
+}
+
+type SyncedContact struct {
+	UUID              string
+	Number            string
+	Name              string // The name in the primary device's address book
+	Avatar            []byte
+	AvatarContentType string
+	Blocked           bool
+	ExpireTimer       uint32
+	Verified          bool
+	InboxPosition     uint32 // Position of the chat in the chat list, 0 if it's not there
+	Archived          bool
+}
+
+func (IncomingSignalMessageContactSync) MessageType() IncomingSignalMessageType {
+	return IncomingSignalMessageTypeContactSync
+}
+func (i IncomingSignalMessageContactSync) Base() IncomingSignalMessageBase {
+	return i.IncomingSignalMessageBase
+}
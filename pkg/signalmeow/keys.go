@@ -122,6 +122,32 @@ func GenerateSignedPreKey(startSignedKeyId uint32, uuidKind UUIDKind, identityKe
 	return signedPreKey
 }
 
+// ApplyPniChangeNumber switches to the new PNI and PNI identity our primary device sent after the phone number
+// of the account changed, and saves it. The signed prekey was already uploaded by the primary device.
+func ApplyPniChangeNumber(device *Device, deviceStore DeviceStore, change IncomingSignalMessagePniChangeNumber) error {
+	if change.UpdatedPni == "" {
+		return fmt.Errorf("phone number change didn't include the new PNI")
+	}
+	identityKeyPair, err := libsignalgo.DeserializeIdentityKeyPair(change.IdentityKeyPair)
+	if err != nil {
+		return fmt.Errorf("failed to deserialize PNI identity key pair: %w", err)
+	}
+	if len(change.SignedPreKey) > 0 {
+		signedPreKey, err := libsignalgo.DeserializeSignedPreKeyRecord(change.SignedPreKey)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize PNI signed prekey: %w", err)
+		}
+		err = device.PreKeyStoreExtras.SaveSignedPreKey(UUID_KIND_PNI, signedPreKey, true)
+		if err != nil {
+			return fmt.Errorf("failed to save PNI signed prekey: %w", err)
+		}
+	}
+	device.Data.PniUuid = change.UpdatedPni
+	device.Data.PniIdentityKeyPair = identityKeyPair
+	device.Data.PniRegistrationId = int(change.RegistrationID)
+	return deviceStore.PutDevice(&device.Data)
+}
+
 func RegisterPreKeys(generatedPreKeys *GeneratedPreKeys, uuidKind UUIDKind, username string, password string) error {
 	// Convert generated prekeys to JSON
 	preKeysJson := []map[string]interface{}{}
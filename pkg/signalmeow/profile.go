@@ -28,6 +28,7 @@ type ProfileResponse struct {
 	About      string
 	AboutEmoji string
 	Avatar     string
+	Credential []byte
 }
 
 type Profile struct {
@@ -36,6 +37,9 @@ type Profile struct {
 	AboutEmoji string
 	AvatarPath string
 	Key        libsignalgo.ProfileKey
+	// Proves to the group server that Key is the profile key of the user, needed to add them to groups.
+	// Nil if the server didn't give us one.
+	Credential *libsignalgo.ExpiringProfileKeyCredential
 }
 
 type ProfileCache struct {
@@ -52,27 +56,35 @@ func ProfileKeyCredentialRequest(ctx context.Context, d *Device, signalId string
 		return nil, err
 	}
 	uuid, err := convertUUIDToByteUUID(signalId)
-	serverPublicParams := serverPublicParams()
+	if err != nil {
+		return nil, err
+	}
+	hexRequest, _, err := profileKeyCredentialRequest(*profileKey, *uuid)
+	return hexRequest, err
+}
 
+// profileKeyCredentialRequest creates a request for an expiring profile key credential, returning it in the
+// hex form used in profile request paths, along with the context needed to read the server's response
+func profileKeyCredentialRequest(profileKey libsignalgo.ProfileKey, uuid libsignalgo.UUID) ([]byte, *libsignalgo.ProfileKeyCredentialRequestContext, error) {
 	requestContext, err := libsignalgo.CreateProfileKeyCredentialRequestContext(
-		serverPublicParams,
-		*uuid,
-		*profileKey,
+		serverPublicParams(),
+		uuid,
+		profileKey,
 	)
 	if err != nil {
 		zlog.Err(err).Msg("CreateProfileKeyCredentialRequestContext error")
-		return nil, err
+		return nil, nil, err
 	}
 
 	request, err := requestContext.ProfileKeyCredentialRequestContextGetRequest()
 	if err != nil {
 		zlog.Err(err).Msg("CreateProfileKeyCredentialRequest error")
-		return nil, err
+		return nil, nil, err
 	}
 
 	// convert request bytes to hexidecimal representation
 	hexRequest := hex.EncodeToString(request[:])
-	return []byte(hexRequest), nil
+	return []byte(hexRequest), requestContext, nil
 }
 
 func ProfileKeyForSignalID(ctx context.Context, d *Device, signalId string) (*libsignalgo.ProfileKey, error) {
@@ -131,6 +143,17 @@ func RetrieveProfileByID(ctx context.Context, d *Device, signalID string) (*Prof
 	return profile, nil
 }
 
+// InvalidateProfileCache makes the next RetrieveProfileByID fetch the profile from the server again
+func InvalidateProfileCache(d *Device, signalID string) {
+	if d.Connection.ProfileCache == nil {
+		return
+	}
+	delete(d.Connection.ProfileCache.profiles, signalID)
+	delete(d.Connection.ProfileCache.errors, signalID)
+	delete(d.Connection.ProfileCache.lastFetched, signalID)
+	// Don't delete avatarPaths, so the avatar is only returned again if it changed
+}
+
 func RetrieveProfileAndAvatarByID(ctx context.Context, d *Device, signalID string) (*Profile, []byte, error) {
 	profile, err := RetrieveProfileByID(ctx, d, signalID)
 	if err != nil {
@@ -182,7 +205,7 @@ func fetchProfileByID(ctx context.Context, d *Device, signalID string) (*Profile
 	}
 	base64AccessKey := base64.StdEncoding.EncodeToString(accessKey[:])
 
-	credentialRequest, err := ProfileKeyCredentialRequest(ctx, d, signalID)
+	credentialRequest, credentialRequestContext, err := profileKeyCredentialRequest(*profileKey, *uuid)
 	if err != nil {
 		zlog.Err(err).Msg("ProfileKeyCredentialRequest error")
 		return nil, err
@@ -257,6 +280,20 @@ func fetchProfileByID(ctx context.Context, d *Device, signalID string) (*Profile
 	}
 	profile.AvatarPath = profileResponse.Avatar
 	profile.Key = *profileKey
+	if len(profileResponse.Credential) > 0 {
+		credentialResponse, err := libsignalgo.NewProfileKeyCredentialResponse(profileResponse.Credential)
+		if err == nil {
+			profile.Credential, err = libsignalgo.ReceiveExpiringProfileKeyCredential(
+				serverPublicParams(),
+				credentialRequestContext,
+				credentialResponse,
+				uint64(time.Now().Unix()),
+			)
+		}
+		if err != nil {
+			zlog.Err(err).Msg("error receiving profile key credential")
+		}
+	}
 
 	return &profile, nil
 }
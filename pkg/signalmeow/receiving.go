@@ -13,6 +13,7 @@ import (
 	"go.mau.fi/mautrix-signal/pkg/libsignalgo"
 	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
 	"go.mau.fi/mautrix-signal/pkg/signalmeow/web"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -144,8 +145,9 @@ func StartReceiveLoops(ctx context.Context, d *Device) (chan SignalConnectionSta
 			case <-ctx.Done():
 				return
 			case <-initialConnectChan:
-				zlog.Info().Msg("Both websockets connected, sending contacts sync request")
+				zlog.Info().Msg("Both websockets connected, sending contacts and keys sync requests")
 				sendContactSyncRequest(ctx, d)
+				sendKeysSyncRequest(ctx, d)
 				return
 			}
 		}
@@ -221,6 +223,9 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 				}
 				zlog.Trace().Msgf("SealedSender senderUUID: %v, senderDeviceID: %v", senderUUID, senderDeviceID)
 
+				// Set if we should ask the sender to resend the message
+				decryptionFailed := false
+
 				if messageType == libsignalgo.CiphertextMessageTypeSenderKey {
 					zlog.Trace().Msg("SealedSender messageType is CiphertextMessageTypeSenderKey ")
 					decryptedText, err := libsignalgo.GroupDecrypt(
@@ -234,6 +239,7 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 							zlog.Warn().Msg("Duplicate message, ignoring")
 						} else {
 							zlog.Err(err).Msg("GroupDecrypt error")
+							decryptionFailed = true
 						}
 					} else {
 						err = stripPadding(&decryptedText)
@@ -257,6 +263,7 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 					result, err = prekeyDecrypt(*senderAddress, usmcContents, device, ctx)
 					if err != nil {
 						zlog.Err(err).Msg("prekeyDecrypt error")
+						decryptionFailed = true
 					}
 
 				} else if messageType == libsignalgo.CiphertextMessageTypeWhisper {
@@ -273,7 +280,12 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 						libsignalgo.NewCallbackContext(ctx),
 					)
 					if err != nil {
-						zlog.Err(err).Msg("Sealed sender Whisper Decryption error")
+						if strings.Contains(err.Error(), "message with old counter") {
+							zlog.Warn().Msg("Duplicate message, ignoring")
+						} else {
+							zlog.Err(err).Msg("Sealed sender Whisper Decryption error")
+							decryptionFailed = true
+						}
 					} else {
 						err = stripPadding(&decryptedText)
 						if err != nil {
@@ -293,31 +305,13 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 
 				} else if messageType == libsignalgo.CiphertextMessageTypePlaintext {
 					zlog.Debug().Msg("SealedSender messageType is CiphertextMessageTypePlaintext")
-					// TODO: handle plaintext (usually DecryptionErrorMessage) and retries
-					// when implementing SenderKey groups
-
-					//plaintextContent, err := libsignalgo.DeserializePlaintextContent(usmcContents)
-					//if err != nil {
-					//	zlog.Err(err).Msg("DeserializePlaintextContent error")
-					//}
-					//body, err := plaintextContent.GetBody()
-					//if err != nil {
-					//	zlog.Err(err).Msg("PlaintextContent GetBody error")
-					//}
-					//content := signalpb.Content{}
-					//err = proto.Unmarshal(body, &content)
-					//if err != nil {
-					//	zlog.Err(err).Msg("PlaintextContent Unmarshal error")
-					//}
-					//result = &DecryptionResult{
-					//	SenderAddress: *senderAddress,
-					//	Content:       &content,
-					//	SealedSender:  true,
-					//}
-
-					return &web.SimpleResponse{
-						Status: responseCode,
-					}, nil
+					// Plaintext messages are used for DecryptionErrorMessages (retry receipts)
+					result, err = plaintextDecrypt(*senderAddress, usmcContents)
+					if err != nil {
+						zlog.Err(err).Msg("plaintextDecrypt error")
+					} else {
+						result.SealedSender = true
+					}
 
 				} else {
 					zlog.Warn().Msg("SealedSender messageType is unknown")
@@ -339,6 +333,14 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 					}
 				}
 
+				// Still couldn't decrypt, so ask the sender to try again
+				if result == nil && decryptionFailed {
+					err = sendDecryptionErrorMessage(ctx, device, senderAddress, usmcContents, messageType, envelope.GetTimestamp())
+					if err != nil {
+						zlog.Err(err).Msg("sendDecryptionErrorMessage error")
+					}
+				}
+
 			} else if *envelope.Type == signalpb.Envelope_PREKEY_BUNDLE {
 				zlog.Debug().Msgf("Received envelope type PREKEY_BUNDLE, verb: %v, path: %v", *req.Verb, *req.Path)
 				sender, err := libsignalgo.NewAddress(
@@ -351,12 +353,27 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 				result, err = prekeyDecrypt(*sender, envelope.Content, device, ctx)
 				if err != nil {
 					zlog.Err(err).Msg("prekeyDecrypt error")
+					err = sendDecryptionErrorMessage(ctx, device, sender, envelope.Content, libsignalgo.CiphertextMessageTypePreKey, envelope.GetTimestamp())
+					if err != nil {
+						zlog.Err(err).Msg("sendDecryptionErrorMessage error")
+					}
 				} else {
 					zlog.Trace().Msgf("prekey decrypt result -  address: %v, data: %v", result.SenderAddress, result.Content)
 				}
 
 			} else if *envelope.Type == signalpb.Envelope_PLAINTEXT_CONTENT {
 				zlog.Debug().Msgf("Received envelope type PLAINTEXT_CONTENT, verb: %v, path: %v", *req.Verb, *req.Path)
+				sender, err := libsignalgo.NewAddress(
+					*envelope.SourceUuid,
+					uint(*envelope.SourceDevice),
+				)
+				if err != nil {
+					return nil, fmt.Errorf("NewAddress error: %v", err)
+				}
+				result, err = plaintextDecrypt(*sender, envelope.Content)
+				if err != nil {
+					zlog.Err(err).Msg("plaintextDecrypt error")
+				}
 
 			} else if *envelope.Type == signalpb.Envelope_CIPHERTEXT {
 				zlog.Debug().Msgf("Received envelope type CIPHERTEXT, verb: %v, path: %v", *req.Verb, *req.Path)
@@ -380,6 +397,10 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 						zlog.Info().Msg("Duplicate message, ignoring")
 					} else {
 						zlog.Err(err).Msg("Whisper Decryption error")
+						err = sendDecryptionErrorMessage(ctx, device, senderAddress, envelope.Content, libsignalgo.CiphertextMessageTypeWhisper, envelope.GetTimestamp())
+						if err != nil {
+							zlog.Err(err).Msg("sendDecryptionErrorMessage error")
+						}
 					}
 				} else {
 					err = stripPadding(&decryptedText)
@@ -450,14 +471,26 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 					return nil, err
 				}
 
-				// TODO: handle more sync messages
+				// They couldn't decrypt something we sent, so resend it
+				if content.DecryptionErrorMessage != nil {
+					err = handleDecryptionErrorMessage(ctx, device, &result.SenderAddress, content.DecryptionErrorMessage)
+					if err != nil {
+						zlog.Err(err).Msg("handleDecryptionErrorMessage error")
+					}
+				}
+
 				if content.SyncMessage != nil {
-					if content.SyncMessage.Sent != nil {
-						if content.SyncMessage.Sent.Message != nil {
-							destination := content.SyncMessage.Sent.DestinationUuid
-							if content.SyncMessage.Sent.Message.GroupV2 != nil {
-								zlog.Debug().Msgf("sync message sent group: %v", content.SyncMessage.Sent.Message.GroupV2)
-								masterKeyBytes := libsignalgo.GroupMasterKey(content.SyncMessage.Sent.Message.GroupV2.MasterKey)
+					if sent := content.SyncMessage.Sent; sent != nil {
+						// Messages and edits we sent from another device
+						sentDataMessage := sent.Message
+						if sent.EditMessage != nil {
+							sentDataMessage = sent.EditMessage.DataMessage
+						}
+						if sentDataMessage != nil {
+							destination := sent.DestinationUuid
+							if sentDataMessage.GroupV2 != nil {
+								zlog.Debug().Msgf("sync message sent group: %v", sentDataMessage.GroupV2)
+								masterKeyBytes := libsignalgo.GroupMasterKey(sentDataMessage.GroupV2.MasterKey)
 								masterKey := masterKeyFromBytes(masterKeyBytes)
 								gid, err := StoreMasterKey(ctx, device, masterKey)
 								if err != nil {
@@ -472,12 +505,24 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 								zlog.Err(err).Msg("")
 								return nil, err
 							}
-							_, err = incomingDataMessage(ctx, device, content.SyncMessage.Sent.Message, device.Data.AciUuid, *destination)
+							if sent.EditMessage != nil {
+								_, err = incomingEditMessage(ctx, device, sent.EditMessage, device.Data.AciUuid, *destination)
+							} else {
+								_, err = incomingDataMessage(ctx, device, sentDataMessage, device.Data.AciUuid, *destination)
+							}
 							if err != nil {
 								zlog.Err(err).Msg("incomingDataMessage error")
 								return nil, err
 							}
 						}
+						// Stories we posted from another device
+						if sent.StoryMessage != nil {
+							err = incomingStoryMessage(ctx, device, sent.StoryMessage, sent.GetTimestamp(), device.Data.AciUuid, device.Data.AciUuid)
+							if err != nil {
+								zlog.Err(err).Msg("incomingStoryMessage error")
+								return nil, err
+							}
+						}
 					}
 					if content.SyncMessage.Contacts != nil {
 						zlog.Debug().Msgf("Recieved sync message contacts")
@@ -488,13 +533,16 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 								zlog.Err(err).Msg("Contacts Sync fetchAndDecryptAttachment error")
 							}
 							// unmarshall contacts
-							contacts, _, err := unmarshalContactDetailsMessages(contactsBytes)
+							contacts, avatars, err := unmarshalContactDetailsMessages(contactsBytes)
 							if err != nil {
 								zlog.Err(err).Msg("Contacts Sync unmarshalContactDetailsMessages error")
 							}
-							// TODO: actually use contacts and avatars
-							// store profile keys
-							for _, contact := range contacts {
+							syncedContacts := make([]SyncedContact, 0, len(contacts))
+							for i, contact := range contacts {
+								if contact.GetUuid() == "" {
+									continue
+								}
+								// store profile keys
 								if contact.ProfileKey != nil {
 									profileKey := libsignalgo.ProfileKey(contact.ProfileKey)
 									err = device.ProfileKeyStore.StoreProfileKey(*contact.Uuid, profileKey, ctx)
@@ -503,6 +551,35 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 										return nil, err
 									}
 								}
+								verified := contact.GetVerified().GetState() == signalpb.Verified_VERIFIED
+								if contact.GetVerified().GetIdentityKey() != nil {
+									err = device.IdentityStoreExtras.SetIdentityVerified(contact.GetUuid(), contact.GetVerified().GetIdentityKey(), verified, ctx)
+									if err != nil {
+										zlog.Err(err).Msg("SetIdentityVerified error")
+									}
+								}
+								syncedContacts = append(syncedContacts, SyncedContact{
+									UUID:              contact.GetUuid(),
+									Number:            contact.GetNumber(),
+									Name:              contact.GetName(),
+									Avatar:            avatars[i],
+									AvatarContentType: contact.GetAvatar().GetContentType(),
+									Blocked:           contact.GetBlocked(),
+									ExpireTimer:       contact.GetExpireTimer(),
+									Verified:          verified,
+									InboxPosition:     contact.GetInboxPosition(),
+									Archived:          contact.GetArchived(),
+								})
+							}
+							if len(syncedContacts) > 0 {
+								device.Connection.IncomingSignalMessageHandler(IncomingSignalMessageContactSync{
+									IncomingSignalMessageBase: IncomingSignalMessageBase{
+										SenderUUID:    device.Data.AciUuid,
+										RecipientUUID: device.Data.AciUuid,
+										Timestamp:     currentMessageTimestamp(),
+									},
+									Contacts: syncedContacts,
+								})
 							}
 						}
 					}
@@ -523,7 +600,16 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 							device.Connection.IncomingSignalMessageHandler(receiptMessage)
 						}
 					}
+					// Everything else our other devices tell us about
+					incomingSyncMessage(ctx, device, content.SyncMessage, theirUuid, envelopeUpdatedPni(envelope))
+				}
 
+				if content.StoryMessage != nil {
+					err := incomingStoryMessage(ctx, device, content.StoryMessage, envelope.GetTimestamp(), theirUuid, device.Data.AciUuid)
+					if err != nil {
+						zlog.Err(err).Msg("incomingStoryMessage error")
+						return nil, err
+					}
 				}
 
 				if content.DataMessage != nil {
@@ -540,6 +626,20 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 					}
 				}
 
+				if content.EditMessage != nil {
+					deliveredTimestamps, err := incomingEditMessage(ctx, device, content.EditMessage, theirUuid, device.Data.AciUuid)
+					if err != nil {
+						zlog.Err(err).Msg("incomingEditMessage error")
+						return nil, err
+					}
+					if len(deliveredTimestamps) > 0 {
+						err := sendDeliveryReceipts(ctx, device, deliveredTimestamps, theirUuid)
+						if err != nil {
+							zlog.Err(err).Msg("sendDeliveryReceipts error")
+						}
+					}
+				}
+
 				if content.TypingMessage != nil {
 					var isTyping = content.TypingMessage.GetAction() == signalpb.TypingMessage_STARTED
 					var typingMessage = IncomingSignalMessageTyping{
@@ -587,6 +687,8 @@ func incomingRequestHandlerWithDevice(device *Device) web.RequestHandlerFunc {
 							receiptType = IncomingSignalMessageReceiptTypeRead
 						case signalpb.ReceiptMessage_DELIVERY:
 							receiptType = IncomingSignalMessageReceiptTypeDelivery
+						case signalpb.ReceiptMessage_VIEWED:
+							receiptType = IncomingSignalMessageReceiptTypeViewed
 						default:
 							zlog.Warn().Msgf("Unknown receipt type: %v", *content.ReceiptMessage.Type)
 						}
@@ -663,6 +765,7 @@ func unmarshalContactDetailsMessages(byteStream []byte) ([]*signalpb.ContactDeta
 		if contactDetails.Avatar != nil {
 			avatarBytes := buf.Next(int(*contactDetails.Avatar.Length))
 			avatarBytesCopy := make([]byte, len(avatarBytes))
+			copy(avatarBytesCopy, avatarBytes)
 			avatarList = append(avatarList, avatarBytesCopy)
 		} else {
 			// If there isn't, append nil so the indicies line up
@@ -738,6 +841,7 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 
 	// If it's a group message, get the ID and invalidate cache if necessary
 	var gidPointer *GroupIdentifier
+	var groupChange *GroupChange
 	if dataMessage.GetGroupV2() != nil {
 		// Pull out the master key then store it ASAP - we should pass around GroupIdentifier
 		groupMasterKeyBytes := dataMessage.GetGroupV2().GetMasterKey()
@@ -750,9 +854,17 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 		gidPointer = &gidValue
 
 		if dataMessage.GetGroupV2().GroupChange != nil {
-			// TODO: don't parse the change	for now, just invalidate our cache
-			zlog.Debug().Msgf("Invalidating group %v due to change: %v", gidValue, dataMessage.GetGroupV2().GroupChange)
-			InvalidateGroupCache(device, gidValue)
+			groupChange, err = decryptGroupChange(masterKey, dataMessage.GetGroupV2().GroupChange)
+			if err != nil {
+				zlog.Err(err).Msgf("Failed to decrypt change to group %v, invalidating cache instead", gidValue)
+				InvalidateGroupCache(device, gidValue)
+				// Get the current state of the group from the server, rather than trusting the change
+				RetrieveGroupByID(ctx, device, gidValue)
+			} else {
+				zlog.Debug().Msgf("Applying change to group %v (revision %v)", gidValue, groupChange.Revision)
+				device.updateGroupCacheWithChange(groupChange)
+				storeGroupChangeProfileKeys(ctx, device, groupChange)
+			}
 		} else if dataMessage.GetGroupV2().GetRevision() > 0 {
 			// Compare revision, and if it's newer, invalidate our cache
 			ourGroup, err := RetrieveGroupByID(ctx, device, gidValue)
@@ -769,6 +881,33 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 
 	var incomingMessages []IncomingSignalMessage
 
+	if groupChange != nil {
+		incomingMessage := IncomingSignalMessageGroupChange{
+			IncomingSignalMessageBase: IncomingSignalMessageBase{
+				SenderUUID:    senderUUID,
+				RecipientUUID: recipientUUID,
+				GroupID:       gidPointer,
+				Timestamp:     dataMessage.GetTimestamp(),
+			},
+			GroupChange: groupChange,
+		}
+		incomingMessages = append(incomingMessages, incomingMessage)
+	}
+
+	// Disappearing message timer changes come as an otherwise empty message with a flag set
+	if dataMessage.GetFlags()&uint32(signalpb.DataMessage_EXPIRATION_TIMER_UPDATE) != 0 {
+		incomingMessage := IncomingSignalMessageExpireTimer{
+			IncomingSignalMessageBase: IncomingSignalMessageBase{
+				SenderUUID:    senderUUID,
+				RecipientUUID: recipientUUID,
+				GroupID:       gidPointer,
+				Timestamp:     dataMessage.GetTimestamp(),
+			},
+			NewExpireTimer: dataMessage.GetExpireTimer(),
+		}
+		incomingMessages = append(incomingMessages, incomingMessage)
+	}
+
 	// Grab quote (reply) info if it exists
 	var quoteData *IncomingSignalMessageQuoteData
 	if dataMessage.Quote != nil {
@@ -778,92 +917,131 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 		}
 	}
 
-	// If there's mentions, add them
-	// TODO: also parse out styles here
-	var mentions []IncomingSignalMessageMentionData
-	if dataMessage.BodyRanges != nil {
-		for _, bodyRange := range dataMessage.BodyRanges {
-			mention := IncomingSignalMessageMentionData{
-				Start:  *bodyRange.Start,
-				Length: *bodyRange.Length,
-			}
-			if mentionUUID := bodyRange.GetMentionUuid(); mentionUUID != "" {
-				mention.MentionedUUID = mentionUUID
-				// Get name from profile db table
-				profile, err := RetrieveProfileByID(ctx, device, mentionUUID)
-				if err != nil {
-					zlog.Err(err).Msg("RetrieveProfileByID error")
-				} else {
-					mention.MentionedName = profile.Name
-				}
-			}
-			mentions = append(mentions, mention)
+	// Replies and reactions to stories point to the story instead of quoting it
+	var storyContext *IncomingSignalMessageStoryContext
+	if dataMessage.StoryContext != nil {
+		storyContext = &IncomingSignalMessageStoryContext{
+			StoryAuthorUUID: dataMessage.GetStoryContext().GetAuthorUuid(),
+			StoryTimestamp:  dataMessage.GetStoryContext().GetSentTimestamp(),
 		}
 	}
 
-	// If there's attachements, handle them (one at a time for now)
+	// If there's mentions or styles, add them
+	mentions, styles := parseBodyRanges(ctx, device, dataMessage.BodyRanges)
+
+	// If there's attachements, handle them. Each one is a separate part of the message.
+	// A single attachment gets the body as its caption, but the body of an album is sent once,
+	// as a text part after the attachments, and only the first part is a reply.
+	isAlbum := len(dataMessage.Attachments) > 1
+	caption := dataMessage.GetBody()
+	if isAlbum {
+		caption = ""
+	}
 	if dataMessage.Attachments != nil {
-		for _, attachmentPointer := range dataMessage.Attachments {
-			bytes, err := fetchAndDecryptAttachment(attachmentPointer)
-			if err != nil {
-				zlog.Err(err).Msg("fetchAndDecryptAttachment error")
-				continue
+		for i, attachmentPointer := range dataMessage.Attachments {
+			base := IncomingSignalMessageBase{
+				SenderUUID:    senderUUID,
+				RecipientUUID: recipientUUID,
+				GroupID:       gidPointer,
+				Timestamp:     dataMessage.GetTimestamp(),
+				ExpiresIn:     dataMessage.GetExpireTimer(),
+				PartIndex:     i,
+				ViewOnce:      dataMessage.GetIsViewOnce(),
+			}
+			if i == 0 {
+				base.Quote = quoteData
+			}
+			if !isAlbum {
+				base.Mentions = mentions
+				base.Styles = styles
 			}
-			// TODO: right now this will be one message per image, each with the same caption
-			if strings.HasPrefix(attachmentPointer.GetContentType(), "image") {
-				incomingMessage := IncomingSignalMessageImage{
-					IncomingSignalMessageBase: IncomingSignalMessageBase{
-						SenderUUID:    senderUUID,
-						RecipientUUID: recipientUUID,
-						GroupID:       gidPointer,
-						Timestamp:     dataMessage.GetTimestamp(),
-						Quote:         quoteData,
-						Mentions:      mentions,
-					},
-					Image:       bytes,
-					Caption:     dataMessage.GetBody(),
-					Filename:    attachmentPointer.GetFileName(),
-					ContentType: attachmentPointer.GetContentType(),
-					Size:        uint64(attachmentPointer.GetSize()),
-					Width:       attachmentPointer.GetWidth(),
-					Height:      attachmentPointer.GetHeight(),
-					BlurHash:    attachmentPointer.GetBlurHash(),
+			contentType := attachmentPointer.GetContentType()
+			flags := attachmentPointer.GetFlags()
+			var incomingMessage IncomingSignalMessage
+			switch {
+			case strings.HasPrefix(contentType, "image"):
+				incomingMessage = IncomingSignalMessageImage{
+					IncomingSignalMessageBase: base,
+					Attachment:                (*AttachmentPointer)(attachmentPointer),
+					Caption:                   caption,
+					Filename:                  attachmentPointer.GetFileName(),
+					ContentType:               contentType,
+					Size:                      uint64(attachmentPointer.GetSize()),
+					Width:                     attachmentPointer.GetWidth(),
+					Height:                    attachmentPointer.GetHeight(),
+					BlurHash:                  attachmentPointer.GetBlurHash(),
 				}
-				incomingMessages = append(incomingMessages, incomingMessage)
-			} else {
-				// Unhandled attachment
-				zlog.Debug().Msgf("Unhandled attachment: %v", attachmentPointer)
-				incomingMessage := IncomingSignalMessageUnhandled{
-					IncomingSignalMessageBase: IncomingSignalMessageBase{
-						SenderUUID:    senderUUID,
-						RecipientUUID: recipientUUID,
-						GroupID:       gidPointer,
-						Timestamp:     dataMessage.GetTimestamp(),
-						Quote:         quoteData,
-						Mentions:      mentions,
-					},
-					Type:   "attachment",
-					Notice: fmt.Sprintf("Unhandled attachment of type: %v", attachmentPointer.GetContentType()),
+			case strings.HasPrefix(contentType, "video"):
+				incomingMessage = IncomingSignalMessageVideo{
+					IncomingSignalMessageBase: base,
+					Attachment:                (*AttachmentPointer)(attachmentPointer),
+					Caption:                   caption,
+					Filename:                  attachmentPointer.GetFileName(),
+					ContentType:               contentType,
+					Size:                      uint64(attachmentPointer.GetSize()),
+					Width:                     attachmentPointer.GetWidth(),
+					Height:                    attachmentPointer.GetHeight(),
+					BlurHash:                  attachmentPointer.GetBlurHash(),
+					Thumbnail:                 attachmentPointer.GetThumbnail(),
+					GIF:                       flags&uint32(signalpb.AttachmentPointer_GIF) != 0,
+				}
+			case strings.HasPrefix(contentType, "audio") && flags&uint32(signalpb.AttachmentPointer_VOICE_MESSAGE) != 0:
+				incomingMessage = IncomingSignalMessageVoiceNote{
+					IncomingSignalMessageBase: base,
+					Attachment:                (*AttachmentPointer)(attachmentPointer),
+					Filename:                  attachmentPointer.GetFileName(),
+					ContentType:               contentType,
+					Size:                      uint64(attachmentPointer.GetSize()),
+				}
+			case strings.HasPrefix(contentType, "audio"):
+				incomingMessage = IncomingSignalMessageAudio{
+					IncomingSignalMessageBase: base,
+					Attachment:                (*AttachmentPointer)(attachmentPointer),
+					Caption:                   caption,
+					Filename:                  attachmentPointer.GetFileName(),
+					ContentType:               contentType,
+					Size:                      uint64(attachmentPointer.GetSize()),
+				}
+			default:
+				incomingMessage = IncomingSignalMessageFile{
+					IncomingSignalMessageBase: base,
+					Attachment:                (*AttachmentPointer)(attachmentPointer),
+					Caption:                   caption,
+					Filename:                  attachmentPointer.GetFileName(),
+					ContentType:               contentType,
+					Size:                      uint64(attachmentPointer.GetSize()),
+					Thumbnail:                 attachmentPointer.GetThumbnail(),
 				}
-				incomingMessages = append(incomingMessages, incomingMessage)
 			}
+			incomingMessages = append(incomingMessages, incomingMessage)
 		}
 	}
 
-	// If there's a body but no attachments, pass along as a text message
-	if dataMessage.Body != nil && dataMessage.Attachments == nil {
+	// Parts that come after the attachments are numbered from here
+	partCount := len(dataMessage.Attachments)
+
+	// If there's a body that wasn't used as a caption, pass along as a text message
+	if dataMessage.Body != nil && (dataMessage.Attachments == nil || isAlbum) {
 		incomingMessage := IncomingSignalMessageText{
 			IncomingSignalMessageBase: IncomingSignalMessageBase{
 				SenderUUID:    senderUUID,
 				RecipientUUID: recipientUUID,
 				GroupID:       gidPointer,
 				Timestamp:     dataMessage.GetTimestamp(),
-				Quote:         quoteData,
 				Mentions:      mentions,
+				Styles:        styles,
+				ExpiresIn:     dataMessage.GetExpireTimer(),
+				PartIndex:     partCount,
 			},
-			Content: dataMessage.GetBody(),
+			Content:  dataMessage.GetBody(),
+			Previews: parsePreviews(dataMessage.GetPreview()),
+		}
+		if !isAlbum {
+			incomingMessage.Quote = quoteData
+			incomingMessage.StoryContext = storyContext
 		}
 		incomingMessages = append(incomingMessages, incomingMessage)
+		partCount++
 	}
 
 	// if a sticker and has data, send it
@@ -880,6 +1058,8 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 					Timestamp:     dataMessage.GetTimestamp(),
 					Quote:         quoteData,
 					Mentions:      mentions,
+					ExpiresIn:     dataMessage.GetExpireTimer(),
+					PartIndex:     partCount,
 				},
 				Width:       *dataMessage.Sticker.Data.Width,
 				Height:      *dataMessage.Sticker.Data.Height,
@@ -889,9 +1069,28 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 				Emoji:       dataMessage.GetSticker().GetEmoji(),
 			}
 			incomingMessages = append(incomingMessages, incomingMessage)
+			partCount++
 		}
 	}
 
+	// Pass along shared contacts, each one is a separate part of the message
+	for i, contact := range dataMessage.GetContact() {
+		incomingMessage := IncomingSignalMessageContact{
+			IncomingSignalMessageBase: IncomingSignalMessageBase{
+				SenderUUID:    senderUUID,
+				RecipientUUID: recipientUUID,
+				GroupID:       gidPointer,
+				Timestamp:     dataMessage.GetTimestamp(),
+				Quote:         quoteData,
+				ExpiresIn:     dataMessage.GetExpireTimer(),
+				PartIndex:     partCount + i,
+			},
+			Contact: contact,
+			Avatar:  (*AttachmentPointer)(contact.GetAvatar().GetAvatar()),
+		}
+		incomingMessages = append(incomingMessages, incomingMessage)
+	}
+
 	// Pass along reactions
 	if dataMessage.Reaction != nil {
 		// make sure target author UUID is lowercase
@@ -904,6 +1103,8 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 				Timestamp:     dataMessage.GetTimestamp(),
 				Quote:         quoteData,
 				Mentions:      mentions,
+				ExpiresIn:     dataMessage.GetExpireTimer(),
+				StoryContext:  storyContext,
 			},
 			Emoji:                  dataMessage.GetReaction().GetEmoji(),
 			Remove:                 dataMessage.GetReaction().GetRemove(),
@@ -923,6 +1124,7 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 				Timestamp:     dataMessage.GetTimestamp(),
 				Quote:         quoteData,
 				Mentions:      mentions,
+				ExpiresIn:     dataMessage.GetExpireTimer(),
 			},
 			TargetMessageTimestamp: dataMessage.GetDelete().GetTargetSentTimestamp(),
 		}
@@ -957,6 +1159,372 @@ func incomingDataMessage(ctx context.Context, device *Device, dataMessage *signa
 	return deliveredTimestamps, nil
 }
 
+// parseBodyRanges splits the body ranges of a message into mentions and text styles
+func parseBodyRanges(ctx context.Context, device *Device, bodyRanges []*signalpb.BodyRange) ([]IncomingSignalMessageMentionData, []StyleRange) {
+	var mentions []IncomingSignalMessageMentionData
+	var styles []StyleRange
+	for _, bodyRange := range bodyRanges {
+		if style, ok := bodyRange.GetAssociatedValue().(*signalpb.BodyRange_Style_); ok {
+			styles = append(styles, StyleRange{
+				Start:  bodyRange.GetStart(),
+				Length: bodyRange.GetLength(),
+				Style:  style.Style,
+			})
+			continue
+		}
+		mention := IncomingSignalMessageMentionData{
+			Start:  bodyRange.GetStart(),
+			Length: bodyRange.GetLength(),
+		}
+		if mentionUUID := bodyRange.GetMentionUuid(); mentionUUID != "" {
+			mention.MentionedUUID = mentionUUID
+			// Get name from profile db table
+			profile, err := RetrieveProfileByID(ctx, device, mentionUUID)
+			if err != nil {
+				zlog.Err(err).Msg("RetrieveProfileByID error")
+			} else {
+				mention.MentionedName = profile.Name
+			}
+		}
+		mentions = append(mentions, mention)
+	}
+	return mentions, styles
+}
+
+func parsePreviews(previews []*signalpb.Preview) []IncomingSignalMessagePreview {
+	var parsed []IncomingSignalMessagePreview
+	for _, preview := range previews {
+		if preview.GetUrl() == "" {
+			continue
+		}
+		parsed = append(parsed, IncomingSignalMessagePreview{
+			URL:         preview.GetUrl(),
+			Title:       preview.GetTitle(),
+			Description: preview.GetDescription(),
+			Date:        preview.GetDate(),
+			Image:       (*AttachmentPointer)(preview.GetImage()),
+		})
+	}
+	return parsed
+}
+
+// envelopeUpdatedPni returns the new PNI the server puts in the envelope of a PniChangeNumber sync message.
+// Field 15 (updatedPni) is still reserved in our copy of SignalService.proto, so it's read from the unknown fields.
+func envelopeUpdatedPni(envelope *signalpb.Envelope) string {
+	unknown := envelope.ProtoReflect().GetUnknown()
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return ""
+		}
+		unknown = unknown[n:]
+		if num == 15 && typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(unknown)
+			if n < 0 {
+				return ""
+			}
+			return string(value)
+		}
+		n = protowire.ConsumeFieldValue(num, typ, unknown)
+		if n < 0 {
+			return ""
+		}
+		unknown = unknown[n:]
+	}
+	return ""
+}
+
+// callEventType gets the event of a call event sync message. Events that were added after our copy of the
+// protobuf (like DELETE) end up in the unknown fields, since the enum is closed.
+func callEventType(callEvent *signalpb.SyncMessage_CallEvent) CallEventType {
+	if callEvent.Event != nil {
+		return CallEventType(callEvent.GetEvent())
+	}
+	unknown := callEvent.ProtoReflect().GetUnknown()
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return CallEventTypeUnknown
+		}
+		unknown = unknown[n:]
+		if num == 6 && typ == protowire.VarintType {
+			value, n := protowire.ConsumeVarint(unknown)
+			if n < 0 {
+				return CallEventTypeUnknown
+			}
+			return CallEventType(value)
+		}
+		n = protowire.ConsumeFieldValue(num, typ, unknown)
+		if n < 0 {
+			return CallEventTypeUnknown
+		}
+		unknown = unknown[n:]
+	}
+	return CallEventTypeUnknown
+}
+
+// incomingSyncMessage passes on the sync messages that aren't handled inline: viewed receipts,
+// and changes to the account that were made on another device
+func incomingSyncMessage(ctx context.Context, device *Device, syncMessage *signalpb.SyncMessage, senderUUID string, updatedPni string) {
+	if senderUUID != device.Data.AciUuid {
+		zlog.Warn().Msgf("Ignoring sync message from %s, sync messages can only come from our own devices", senderUUID)
+		return
+	}
+	if device.Connection.IncomingSignalMessageHandler == nil {
+		return
+	}
+	ourUUID := device.Data.AciUuid
+	currentTimestamp := currentMessageTimestamp() // most sync messages don't have a timestamp
+	base := IncomingSignalMessageBase{
+		SenderUUID:    ourUUID,
+		RecipientUUID: ourUUID,
+		Timestamp:     currentTimestamp,
+	}
+	var incomingMessages []IncomingSignalMessage
+
+	for _, viewed := range syncMessage.GetViewed() {
+		incomingMessages = append(incomingMessages, IncomingSignalMessageReceipt{
+			IncomingSignalMessageBase: base,
+			ReceiptType:               IncomingSignalMessageReceiptTypeViewed,
+			OriginalTimestamp:         viewed.GetTimestamp(),
+			OriginalSender:            viewed.GetSenderUuid(),
+		})
+	}
+	if viewOnceOpen := syncMessage.GetViewOnceOpen(); viewOnceOpen != nil {
+		incomingMessages = append(incomingMessages, IncomingSignalMessageViewOnceOpen{
+			IncomingSignalMessageBase: base,
+			TargetAuthorUUID:          viewOnceOpen.GetSenderUuid(),
+			TargetMessageTimestamp:    viewOnceOpen.GetTimestamp(),
+		})
+	}
+	if blocked := syncMessage.GetBlocked(); blocked != nil {
+		blockedMessage := IncomingSignalMessageBlocked{
+			IncomingSignalMessageBase: base,
+			BlockedUUIDs:              blocked.GetUuids(),
+		}
+		for _, groupID := range blocked.GetGroupIds() {
+			blockedMessage.BlockedGroupIDs = append(blockedMessage.BlockedGroupIDs, GroupIdentifier(base64.StdEncoding.EncodeToString(groupID)))
+		}
+		incomingMessages = append(incomingMessages, blockedMessage)
+	}
+	if configuration := syncMessage.GetConfiguration(); configuration != nil {
+		incomingMessages = append(incomingMessages, IncomingSignalMessageConfiguration{
+			IncomingSignalMessageBase:      base,
+			ReadReceipts:                   configuration.GetReadReceipts(),
+			TypingIndicators:               configuration.GetTypingIndicators(),
+			LinkPreviews:                   configuration.GetLinkPreviews(),
+			UnidentifiedDeliveryIndicators: configuration.GetUnidentifiedDeliveryIndicators(),
+		})
+	}
+	for _, operation := range syncMessage.GetStickerPackOperation() {
+		incomingMessages = append(incomingMessages, IncomingSignalMessageStickerPackOperation{
+			IncomingSignalMessageBase: base,
+			PackID:                    operation.GetPackId(),
+			PackKey:                   operation.GetPackKey(),
+			Remove:                    operation.GetType() == signalpb.SyncMessage_StickerPackOperation_REMOVE,
+		})
+	}
+	if fetchLatest := syncMessage.GetFetchLatest(); fetchLatest != nil {
+		incomingMessages = append(incomingMessages, IncomingSignalMessageFetchLatest{
+			IncomingSignalMessageBase: base,
+			FetchType:                 fetchLatest.GetType(),
+		})
+	}
+	if keys := syncMessage.GetKeys(); keys != nil {
+		incomingMessages = append(incomingMessages, IncomingSignalMessageKeys{
+			IncomingSignalMessageBase: base,
+			StorageServiceKey:         keys.GetStorageService(),
+		})
+	}
+	if response := syncMessage.GetMessageRequestResponse(); response != nil {
+		responseMessage := IncomingSignalMessageMessageRequestResponse{
+			IncomingSignalMessageBase: base,
+			ResponseType:              response.GetType(),
+		}
+		if response.GetGroupId() != nil {
+			gid := GroupIdentifier(base64.StdEncoding.EncodeToString(response.GetGroupId()))
+			responseMessage.GroupID = &gid
+			responseMessage.RecipientUUID = string(gid)
+		} else {
+			responseMessage.RecipientUUID = response.GetThreadUuid()
+		}
+		if responseMessage.RecipientUUID == "" {
+			zlog.Warn().Msg("Message request response sync message has no chat")
+		} else {
+			incomingMessages = append(incomingMessages, responseMessage)
+		}
+	}
+	if callEvent := syncMessage.GetCallEvent(); callEvent != nil {
+		peerUUID, err := uuid.FromBytes(callEvent.GetPeerUuid())
+		if err != nil {
+			zlog.Err(err).Msg("Call event sync message has invalid peer UUID")
+		} else {
+			callMessage := IncomingSignalMessageCallEvent{
+				IncomingSignalMessageBase: base,
+				CallID:                    callEvent.GetId(),
+				Video:                     callEvent.GetType() == signalpb.SyncMessage_CallEvent_VIDEO_CALL,
+				Outgoing:                  callEvent.GetDirection() == signalpb.SyncMessage_CallEvent_OUTGOING,
+				Event:                     callEventType(callEvent),
+			}
+			callMessage.RecipientUUID = peerUUID.String()
+			if callEvent.GetTimestamp() != 0 {
+				callMessage.Timestamp = callEvent.GetTimestamp()
+			}
+			incomingMessages = append(incomingMessages, callMessage)
+		}
+	}
+	if pniChangeNumber := syncMessage.GetPniChangeNumber(); pniChangeNumber != nil {
+		incomingMessages = append(incomingMessages, IncomingSignalMessagePniChangeNumber{
+			IncomingSignalMessageBase: base,
+			IdentityKeyPair:           pniChangeNumber.GetIdentityKeyPair(),
+			SignedPreKey:              pniChangeNumber.GetSignedPreKey(),
+			RegistrationID:            pniChangeNumber.GetRegistrationId(),
+			UpdatedPni:                updatedPni,
+		})
+	}
+
+	for _, incomingMessage := range incomingMessages {
+		err := device.Connection.IncomingSignalMessageHandler(incomingMessage)
+		if err != nil {
+			zlog.Err(err).Msgf("IncomingSignalMessageHandler error for sync message type %v", incomingMessage.MessageType())
+		}
+	}
+}
+
+// incomingStoryMessage passes on a story. Stories don't have their own timestamp,
+// the timestamp of the envelope (or sync message) they came in is used instead.
+func incomingStoryMessage(ctx context.Context, device *Device, storyMessage *signalpb.StoryMessage, timestamp uint64, senderUUID string, recipientUUID string) error {
+	if storyMessage.ProfileKey != nil && senderUUID != device.Data.AciUuid {
+		profileKey := libsignalgo.ProfileKey(storyMessage.ProfileKey)
+		err := device.ProfileKeyStore.StoreProfileKey(senderUUID, profileKey, ctx)
+		if err != nil {
+			zlog.Err(err).Msg("StoreProfileKey error")
+			return err
+		}
+	}
+
+	var gidPointer *GroupIdentifier
+	if storyMessage.GetGroup() != nil {
+		masterKey := masterKeyFromBytes(libsignalgo.GroupMasterKey(storyMessage.GetGroup().GetMasterKey()))
+		gidValue, err := StoreMasterKey(ctx, device, masterKey)
+		if err != nil {
+			zlog.Err(err).Msg("StoreMasterKey error")
+			return err
+		}
+		gidPointer = &gidValue
+	}
+
+	incomingMessage := IncomingSignalMessageStory{
+		IncomingSignalMessageBase: IncomingSignalMessageBase{
+			SenderUUID:    senderUUID,
+			RecipientUUID: recipientUUID,
+			GroupID:       gidPointer,
+			Timestamp:     timestamp,
+		},
+		AllowsReplies: storyMessage.GetAllowsReplies(),
+	}
+	if attachmentPointer := storyMessage.GetFileAttachment(); attachmentPointer != nil {
+		incomingMessage.Mentions, incomingMessage.Styles = parseBodyRanges(ctx, device, storyMessage.GetBodyRanges())
+		incomingMessage.Attachment = (*AttachmentPointer)(attachmentPointer)
+		incomingMessage.Caption = attachmentPointer.GetCaption()
+		incomingMessage.Filename = attachmentPointer.GetFileName()
+		incomingMessage.ContentType = attachmentPointer.GetContentType()
+		incomingMessage.Size = uint64(attachmentPointer.GetSize())
+		incomingMessage.Width = attachmentPointer.GetWidth()
+		incomingMessage.Height = attachmentPointer.GetHeight()
+	} else if textAttachment := storyMessage.GetTextAttachment(); textAttachment != nil {
+		incomingMessage.TextAttachment = parseStoryTextAttachment(textAttachment)
+	} else {
+		zlog.Warn().Msgf("Story from %s at %d has no content", senderUUID, timestamp)
+		return nil
+	}
+
+	if device.Connection.IncomingSignalMessageHandler != nil {
+		return device.Connection.IncomingSignalMessageHandler(incomingMessage)
+	}
+	return nil
+}
+
+func parseStoryTextAttachment(textAttachment *signalpb.TextAttachment) *StoryTextAttachment {
+	parsed := &StoryTextAttachment{
+		Text:            textAttachment.GetText(),
+		TextStyle:       textAttachment.GetTextStyle(),
+		ForegroundColor: textAttachment.GetTextForegroundColor(),
+		BackgroundColor: textAttachment.GetTextBackgroundColor(),
+		Color:           textAttachment.GetColor(),
+	}
+	if gradient := textAttachment.GetGradient(); gradient != nil {
+		parsed.Gradient = &StoryGradient{
+			Colors:    gradient.GetColors(),
+			Positions: gradient.GetPositions(),
+			Angle:     gradient.GetAngle(),
+		}
+		// Older clients only send a start and end color
+		if len(parsed.Gradient.Colors) == 0 {
+			parsed.Gradient.Colors = []uint32{gradient.GetStartColor(), gradient.GetEndColor()}
+			parsed.Gradient.Positions = []float32{0, 1}
+		}
+	}
+	if previews := parsePreviews([]*signalpb.Preview{textAttachment.GetPreview()}); len(previews) > 0 {
+		parsed.Preview = &previews[0]
+	}
+	return parsed
+}
+
+// incomingEditMessage passes on the new text of an edited message. The data message of an edit
+// is a full replacement of the original, but only the text (or caption) can change.
+func incomingEditMessage(ctx context.Context, device *Device, editMessage *signalpb.EditMessage, senderUUID string, recipientUUID string) ([]uint64, error) {
+	deliveredTimestamps := make([]uint64, 0)
+	dataMessage := editMessage.GetDataMessage()
+	if dataMessage == nil {
+		return deliveredTimestamps, fmt.Errorf("edit message without data message")
+	}
+
+	var gidPointer *GroupIdentifier
+	if dataMessage.GetGroupV2() != nil {
+		masterKey := masterKeyFromBytes(libsignalgo.GroupMasterKey(dataMessage.GetGroupV2().GetMasterKey()))
+		gidValue, err := StoreMasterKey(ctx, device, masterKey)
+		if err != nil {
+			zlog.Err(err).Msg("StoreMasterKey error")
+			return deliveredTimestamps, err
+		}
+		gidPointer = &gidValue
+	}
+
+	// The text is in the same part as in incomingDataMessage: the first one,
+	// unless the message is an album, which has its text after the attachments.
+	partIndex := 0
+	if len(dataMessage.Attachments) > 1 {
+		partIndex = len(dataMessage.Attachments)
+	}
+	mentions, styles := parseBodyRanges(ctx, device, dataMessage.BodyRanges)
+	incomingMessage := IncomingSignalMessageEdit{
+		IncomingSignalMessageBase: IncomingSignalMessageBase{
+			SenderUUID:    senderUUID,
+			RecipientUUID: recipientUUID,
+			GroupID:       gidPointer,
+			Timestamp:     dataMessage.GetTimestamp(),
+			Mentions:      mentions,
+			Styles:        styles,
+			ExpiresIn:     dataMessage.GetExpireTimer(),
+			PartIndex:     partIndex,
+		},
+		TargetMessageTimestamp: editMessage.GetTargetSentTimestamp(),
+		Content:                dataMessage.GetBody(),
+		Caption:                len(dataMessage.Attachments) == 1,
+	}
+
+	if device.Connection.IncomingSignalMessageHandler != nil {
+		err := device.Connection.IncomingSignalMessageHandler(incomingMessage)
+		if err != nil {
+			zlog.Err(err).Msg("IncomingSignalMessageHandler error")
+		} else {
+			deliveredTimestamps = append(deliveredTimestamps, incomingMessage.Timestamp)
+		}
+	}
+	return deliveredTimestamps, nil
+}
+
 func sendDeliveryReceipts(ctx context.Context, device *Device, deliveredTimestamps []uint64, senderUUID string) error {
 	// Send delivery receipts
 	if len(deliveredTimestamps) > 0 {
@@ -1041,6 +1609,35 @@ func sealedSenderDecrypt(envelope *signalpb.Envelope, device *Device, ctx contex
 	return DecryptionResult, nil
 }
 
+func plaintextDecrypt(sender libsignalgo.Address, plaintextContentBytes []byte) (*DecryptionResult, error) {
+	plaintextContent, err := libsignalgo.DeserializePlaintextContent(plaintextContentBytes)
+	if err != nil {
+		err = fmt.Errorf("DeserializePlaintextContent error: %v", err)
+		return nil, err
+	}
+	body, err := plaintextContent.GetBody()
+	if err != nil {
+		err = fmt.Errorf("PlaintextContent GetBody error: %v", err)
+		return nil, err
+	}
+	err = stripPadding(&body)
+	if err != nil {
+		err = fmt.Errorf("stripPadding error: %v", err)
+		return nil, err
+	}
+	content := &signalpb.Content{}
+	err = proto.Unmarshal(body, content)
+	if err != nil {
+		err = fmt.Errorf("Unmarshal error: %v", err)
+		return nil, err
+	}
+	DecryptionResult := &DecryptionResult{
+		SenderAddress: sender,
+		Content:       content,
+	}
+	return DecryptionResult, nil
+}
+
 func prekeyDecrypt(sender libsignalgo.Address, encryptedContent []byte, device *Device, ctx context.Context) (*DecryptionResult, error) {
 	preKeyMessage, err := libsignalgo.DeserializePreKeyMessage(encryptedContent)
 	if err != nil {
@@ -0,0 +1,336 @@
+package signalmeow
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.mau.fi/mautrix-signal/pkg/libsignalgo"
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+	"go.mau.fi/mautrix-signal/pkg/signalmeow/web"
+)
+
+// Retry receipts: when a message can't be decrypted, the receiver sends a DecryptionErrorMessage
+// back to the sender, who resets the session and sends the message again.
+
+const (
+	// How long sent messages are kept around in case a recipient asks for them to be resent
+	sentMessageCacheMaxAge = 24 * time.Hour
+	// How many sent messages are kept per recipient, so that sending to a big group doesn't push out everything else
+	sentMessagesPerRecipient = 100
+	// How often the whole cache is checked for expired messages
+	sentMessageCachePruneInterval = 1 * time.Hour
+)
+
+type sentMessage struct {
+	timestamp uint64
+	content   *signalpb.Content
+	sentAt    time.Time
+}
+
+type SentMessageCache struct {
+	lock        sync.Mutex
+	byRecipient map[string][]sentMessage // Oldest first
+	lastPrune   time.Time
+}
+
+// initRetryState creates the sent message cache and the retry request limiter. Both are used from
+// the send path and the receive loop at the same time, so they must only be created once.
+func (d *Device) initRetryState() {
+	d.Connection.retryStateOnce.Do(func() {
+		d.Connection.SentMessageCache = &SentMessageCache{
+			byRecipient: make(map[string][]sentMessage),
+			lastPrune:   time.Now(),
+		}
+		d.Connection.DecryptionErrorLimiter = &DecryptionErrorLimiter{
+			sentAt: make(map[string][]time.Time),
+		}
+	})
+}
+
+// dropExpiredSentMessages removes the messages that are too old to be resent
+func dropExpiredSentMessages(messages []sentMessage) []sentMessage {
+	for len(messages) > 0 && time.Since(messages[0].sentAt) > sentMessageCacheMaxAge {
+		messages = messages[1:]
+	}
+	return messages
+}
+
+func (d *Device) rememberSentMessage(recipientUuid string, timestamp uint64, content *signalpb.Content) {
+	// Only bother with things that are worth resending
+	if content.DataMessage == nil && content.EditMessage == nil {
+		return
+	}
+	d.initRetryState()
+	cache := d.Connection.SentMessageCache
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	if time.Since(cache.lastPrune) > sentMessageCachePruneInterval {
+		for recipient, messages := range cache.byRecipient {
+			if messages = dropExpiredSentMessages(messages); len(messages) == 0 {
+				delete(cache.byRecipient, recipient)
+			} else {
+				cache.byRecipient[recipient] = messages
+			}
+		}
+		cache.lastPrune = time.Now()
+	}
+
+	messages := dropExpiredSentMessages(cache.byRecipient[recipientUuid])
+	for i, message := range messages {
+		if message.timestamp == timestamp {
+			messages = append(messages[:i], messages[i+1:]...)
+			break
+		}
+	}
+	messages = append(messages, sentMessage{timestamp: timestamp, content: content, sentAt: time.Now()})
+	if len(messages) > sentMessagesPerRecipient {
+		messages = messages[len(messages)-sentMessagesPerRecipient:]
+	}
+	cache.byRecipient[recipientUuid] = messages
+}
+
+func (d *Device) recentlySentMessage(recipientUuid string, timestamp uint64) *signalpb.Content {
+	d.initRetryState()
+	cache := d.Connection.SentMessageCache
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	for _, message := range cache.byRecipient[recipientUuid] {
+		if message.timestamp == timestamp && time.Since(message.sentAt) <= sentMessageCacheMaxAge {
+			return message.content
+		}
+	}
+	return nil
+}
+
+const (
+	// How many retries can be requested from one sender device within decryptionErrorInterval.
+	// A broken session usually breaks a few messages in a row, but a misbehaving sender shouldn't make us spam it.
+	decryptionErrorBurst    = 5
+	decryptionErrorInterval = 1 * time.Minute
+)
+
+// DecryptionErrorLimiter rate limits the retry requests we send, per sender device
+type DecryptionErrorLimiter struct {
+	lock   sync.Mutex
+	sentAt map[string][]time.Time
+}
+
+// allowDecryptionErrorMessage returns whether a retry request can be sent to the given device right now,
+// and counts it if so.
+func (d *Device) allowDecryptionErrorMessage(senderUuid string, senderDeviceID uint) bool {
+	d.initRetryState()
+	limiter := d.Connection.DecryptionErrorLimiter
+	limiter.lock.Lock()
+	defer limiter.lock.Unlock()
+
+	key := fmt.Sprintf("%s:%d", senderUuid, senderDeviceID)
+	recent := limiter.sentAt[key]
+	for len(recent) > 0 && time.Since(recent[0]) > decryptionErrorInterval {
+		recent = recent[1:]
+	}
+	if len(recent) >= decryptionErrorBurst {
+		limiter.sentAt[key] = recent
+		return false
+	}
+	limiter.sentAt[key] = append(recent, time.Now())
+	return true
+}
+
+// sendDecryptionErrorMessage tells the sender of a message we failed to decrypt that
+// they should reset the session and send it again.
+func sendDecryptionErrorMessage(
+	ctx context.Context,
+	d *Device,
+	senderAddress *libsignalgo.Address,
+	originalContents []byte,
+	originalType libsignalgo.CiphertextMessageType,
+	originalTimestamp uint64,
+) error {
+	senderUuid, err := senderAddress.Name()
+	if err != nil {
+		return err
+	}
+	senderDeviceID, err := senderAddress.DeviceID()
+	if err != nil {
+		return err
+	}
+	if !d.allowDecryptionErrorMessage(senderUuid, senderDeviceID) {
+		zlog.Warn().Msgf("Not sending decryption error message to %v:%v for message %v, too many were sent recently", senderUuid, senderDeviceID, originalTimestamp)
+		return nil
+	}
+	zlog.Info().Msgf("Sending decryption error message to %v:%v for message %v", senderUuid, senderDeviceID, originalTimestamp)
+
+	dem, err := libsignalgo.DecryptionErrorMessageForOriginalMessage(originalContents, uint8(originalType), originalTimestamp, senderDeviceID)
+	if err != nil {
+		return err
+	}
+	plaintextContent, err := libsignalgo.PlaintextContentFromDecryptionErrorMessage(*dem)
+	if err != nil {
+		return err
+	}
+
+	// We need a session to know the registration ID of the device we're sending to
+	sessionRecord, err := d.SessionStore.LoadSession(senderAddress, ctx)
+	if err == nil && sessionRecord == nil {
+		FetchAndProcessPreKey(ctx, d, senderUuid, int(senderDeviceID))
+		sessionRecord, err = d.SessionStore.LoadSession(senderAddress, ctx)
+	}
+	if err != nil {
+		return err
+	} else if sessionRecord == nil {
+		return fmt.Errorf("no session for %v:%v", senderUuid, senderDeviceID)
+	}
+	destinationRegistrationID, err := sessionRecord.GetRemoteRegistrationID()
+	if err != nil {
+		return err
+	}
+
+	accessKey, err := accessKeyForSignalID(ctx, d, senderUuid)
+	if err != nil {
+		zlog.Err(err).Msg("Error getting access key")
+	}
+	var envelopeType int
+	var payload []byte
+	if accessKey != nil {
+		ciphertextMessage, err := libsignalgo.NewCiphertextMessage(*plaintextContent)
+		if err != nil {
+			return err
+		}
+		cert, err := senderCertificate(d)
+		if err != nil {
+			return err
+		}
+		usmc, err := libsignalgo.NewUnidentifiedSenderMessageContent(
+			ciphertextMessage,
+			cert,
+			libsignalgo.UnidentifiedSenderMessageContentHintImplicit,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		payload, err = libsignalgo.SealedSenderEncrypt(usmc, senderAddress, d.IdentityStore, libsignalgo.NewCallbackContext(ctx))
+		if err != nil {
+			return err
+		}
+		envelopeType = int(signalpb.Envelope_UNIDENTIFIED_SENDER)
+	} else {
+		payload, err = plaintextContent.Serialize()
+		if err != nil {
+			return err
+		}
+		envelopeType = int(signalpb.Envelope_PLAINTEXT_CONTENT)
+	}
+
+	outgoingMessages := MyMessages{
+		Timestamp: int64(currentMessageTimestamp()),
+		Online:    false,
+		Urgent:    false,
+		Messages: []MyMessage{{
+			Type:                      envelopeType,
+			DestinationDeviceID:       int(senderDeviceID),
+			DestinationRegistrationID: int(destinationRegistrationID),
+			Content:                   base64.StdEncoding.EncodeToString(payload),
+		}},
+	}
+	jsonBytes, err := json.Marshal(outgoingMessages)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/v1/messages/%v", senderUuid)
+	request := web.CreateWSRequest("PUT", path, jsonBytes, nil, nil)
+	var response *signalpb.WebSocketResponseMessage
+	if accessKey != nil {
+		request.Headers = append(request.Headers, "unidentified-access-key:"+base64.StdEncoding.EncodeToString(accessKey[:]))
+		response, err = d.Connection.UnauthedWS.SendRequest(ctx, request)
+	} else {
+		response, err = d.Connection.AuthedWS.SendRequest(ctx, request)
+	}
+	if err != nil {
+		return err
+	}
+	if *response.Status != 200 {
+		return fmt.Errorf("Unexpected status code while sending decryption error message: %v", *response.Status)
+	}
+	return nil
+}
+
+// handleDecryptionErrorMessage handles a recipient telling us they couldn't decrypt one of our messages:
+// the broken session is archived and the message is resent if we still have it.
+func handleDecryptionErrorMessage(ctx context.Context, d *Device, senderAddress *libsignalgo.Address, serializedDEM []byte) error {
+	dem, err := libsignalgo.DeserializeDecryptionErrorMessage(serializedDEM)
+	if err != nil {
+		return err
+	}
+	senderUuid, err := senderAddress.Name()
+	if err != nil {
+		return err
+	}
+	senderDeviceID, err := senderAddress.DeviceID()
+	if err != nil {
+		return err
+	}
+	originalTime, err := dem.GetTimestamp()
+	if err != nil {
+		return err
+	}
+	originalTimestamp := uint64(originalTime.UnixMilli())
+	originalDeviceID, err := dem.GetDeviceID()
+	if err != nil {
+		return err
+	}
+	zlog.Info().Msgf("Received decryption error message from %v:%v for message %v", senderUuid, senderDeviceID, originalTimestamp)
+	if int(originalDeviceID) != d.Data.DeviceId {
+		zlog.Debug().Msgf("Decryption error message is for another one of our devices (%v), ignoring", originalDeviceID)
+		return nil
+	}
+
+	ratchetKey, err := dem.GetRatchetKey()
+	if err != nil {
+		return err
+	}
+	if ratchetKey != nil {
+		// A 1:1 session is broken, archive it so a new one is made
+		sessionRecord, err := d.SessionStore.LoadSession(senderAddress, ctx)
+		if err != nil {
+			return err
+		}
+		if sessionRecord != nil {
+			matches, err := sessionRecord.CurrentRatchetKeyMatches(ratchetKey)
+			if err != nil {
+				return err
+			}
+			if matches {
+				zlog.Debug().Msgf("Archiving session with %v:%v", senderUuid, senderDeviceID)
+				err = sessionRecord.ArchiveCurrentState()
+				if err != nil {
+					return err
+				}
+				err = d.SessionStore.StoreSession(senderAddress, sessionRecord, ctx)
+				if err != nil {
+					return err
+				}
+				FetchAndProcessPreKey(ctx, d, senderUuid, int(senderDeviceID))
+			}
+		}
+	} else {
+		// A sender key message failed, so they need our sender key again
+		err = d.SenderKeyStoreExtras.ClearSenderKeySharedWithUUID(senderUuid, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	content := d.recentlySentMessage(senderUuid, originalTimestamp)
+	if content == nil {
+		zlog.Warn().Msgf("Message %v to %v is no longer cached, can't resend it", originalTimestamp, senderUuid)
+		return nil
+	}
+	_, err = sendContent(ctx, d, senderUuid, originalTimestamp, content, 0)
+	return err
+}
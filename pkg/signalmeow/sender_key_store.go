@@ -12,12 +12,39 @@ import (
 )
 
 var _ libsignalgo.SenderKeyStore = (*SQLStore)(nil)
+var _ SenderKeyStoreExtras = (*SQLStore)(nil)
 
 const (
 	loadSenderKeyQuery  = `SELECT key_record FROM signalmeow_sender_keys WHERE our_aci_uuid=$1 AND sender_uuid=$2 AND sender_device_id=$3 AND distribution_id=$4`
 	storeSenderKeyQuery = `INSERT INTO signalmeow_sender_keys (our_aci_uuid, sender_uuid, sender_device_id, distribution_id, key_record) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (our_aci_uuid, sender_uuid, sender_device_id, distribution_id) DO UPDATE SET key_record=excluded.key_record`
+
+	loadDistributionIDQuery   = `SELECT distribution_id FROM signalmeow_sender_key_distributions WHERE our_aci_uuid=$1 AND group_identifier=$2`
+	storeDistributionIDQuery  = `INSERT INTO signalmeow_sender_key_distributions (our_aci_uuid, group_identifier, distribution_id) VALUES ($1, $2, $3) ON CONFLICT (our_aci_uuid, group_identifier) DO NOTHING`
+	rotateDistributionIDQuery = `UPDATE signalmeow_sender_key_distributions SET distribution_id=$3 WHERE our_aci_uuid=$1 AND group_identifier=$2`
+	sharedWithQuery           = `SELECT their_aci_uuid, their_device_id FROM signalmeow_sender_key_shared WHERE our_aci_uuid=$1 AND distribution_id=$2`
+	markSharedWithQuery       = `INSERT INTO signalmeow_sender_key_shared (our_aci_uuid, distribution_id, their_aci_uuid, their_device_id) VALUES ($1, $2, $3, $4) ON CONFLICT (our_aci_uuid, distribution_id, their_aci_uuid, their_device_id) DO NOTHING`
+	clearSharedWithUUIDQuery  = `DELETE FROM signalmeow_sender_key_shared WHERE our_aci_uuid=$1 AND their_aci_uuid=$2`
+	clearSharedWithGroupQuery = `DELETE FROM signalmeow_sender_key_shared WHERE our_aci_uuid=$1 AND distribution_id=$2`
 )
 
+type SenderKeyStoreExtras interface {
+	// DistributionIDForGroup returns the distribution ID we use for our own sender key in the given group,
+	// creating a new one if we haven't sent to the group before.
+	DistributionIDForGroup(groupIdentifier GroupIdentifier, ctx context.Context) (uuid.UUID, error)
+	// SenderKeySharedWith returns the devices that have already received our sender key for the distribution ID.
+	SenderKeySharedWith(distributionID uuid.UUID, ctx context.Context) (map[string][]uint, error)
+	// MarkSenderKeySharedWith records that the given devices have received our sender key.
+	MarkSenderKeySharedWith(distributionID uuid.UUID, theirUuid string, deviceIDs []uint, ctx context.Context) error
+	// ClearSenderKeySharedWithUUID forgets that any of our sender keys were shared with the given UUID,
+	// so they will be redistributed on the next group send.
+	ClearSenderKeySharedWithUUID(theirUuid string, ctx context.Context) error
+	// ClearSenderKeySharedWithGroup forgets who received our sender key for the distribution ID.
+	ClearSenderKeySharedWithGroup(distributionID uuid.UUID, ctx context.Context) error
+	// RotateDistributionIDForGroup replaces our distribution ID for the group with a new one, so that a new
+	// sender key is created and distributed on the next send, and forgets who received the old one.
+	RotateDistributionIDForGroup(groupIdentifier GroupIdentifier, ctx context.Context) (uuid.UUID, error)
+}
+
 func scanSenderKey(row scannable) (*libsignalgo.SenderKeyRecord, error) {
 	var key []byte
 	err := row.Scan(&key)
@@ -75,3 +102,90 @@ func (s *SQLStore) StoreSenderKey(sender libsignalgo.Address, distributionID uui
 	err = tx.Commit()
 	return err
 }
+
+func (s *SQLStore) DistributionIDForGroup(groupIdentifier GroupIdentifier, ctx context.Context) (uuid.UUID, error) {
+	var distributionIdString string
+	err := s.db.QueryRow(loadDistributionIDQuery, s.AciUuid, groupIdentifier).Scan(&distributionIdString)
+	if err == nil {
+		return uuid.Parse(distributionIdString)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, err
+	}
+	_, err = s.db.Exec(storeDistributionIDQuery, s.AciUuid, groupIdentifier, uuid.New().String())
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// Read it back in case someone else inserted one in the meantime
+	err = s.db.QueryRow(loadDistributionIDQuery, s.AciUuid, groupIdentifier).Scan(&distributionIdString)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(distributionIdString)
+}
+
+func (s *SQLStore) RotateDistributionIDForGroup(groupIdentifier GroupIdentifier, ctx context.Context) (uuid.UUID, error) {
+	oldDistributionID, err := s.DistributionIDForGroup(groupIdentifier, ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	newDistributionID := uuid.New()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	_, err = tx.Exec(rotateDistributionIDQuery, s.AciUuid, groupIdentifier, newDistributionID.String())
+	if err != nil {
+		_ = tx.Rollback()
+		return uuid.Nil, err
+	}
+	_, err = tx.Exec(clearSharedWithGroupQuery, s.AciUuid, oldDistributionID.String())
+	if err != nil {
+		_ = tx.Rollback()
+		return uuid.Nil, err
+	}
+	return newDistributionID, tx.Commit()
+}
+
+func (s *SQLStore) SenderKeySharedWith(distributionID uuid.UUID, ctx context.Context) (map[string][]uint, error) {
+	rows, err := s.db.Query(sharedWithQuery, s.AciUuid, distributionID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	sharedWith := make(map[string][]uint)
+	for rows.Next() {
+		var theirUuid string
+		var deviceId uint
+		err = rows.Scan(&theirUuid, &deviceId)
+		if err != nil {
+			return nil, err
+		}
+		sharedWith[theirUuid] = append(sharedWith[theirUuid], deviceId)
+	}
+	return sharedWith, rows.Err()
+}
+
+func (s *SQLStore) MarkSenderKeySharedWith(distributionID uuid.UUID, theirUuid string, deviceIDs []uint, ctx context.Context) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, deviceId := range deviceIDs {
+		_, err = tx.Exec(markSharedWithQuery, s.AciUuid, distributionID.String(), theirUuid, deviceId)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SQLStore) ClearSenderKeySharedWithUUID(theirUuid string, ctx context.Context) error {
+	_, err := s.db.Exec(clearSharedWithUUIDQuery, s.AciUuid, theirUuid)
+	return err
+}
+
+func (s *SQLStore) ClearSenderKeySharedWithGroup(distributionID uuid.UUID, ctx context.Context) error {
+	_, err := s.db.Exec(clearSharedWithGroupQuery, s.AciUuid, distributionID.String())
+	return err
+}
@@ -10,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"go.mau.fi/mautrix-signal/pkg/libsignalgo"
 	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
 	"go.mau.fi/mautrix-signal/pkg/signalmeow/web"
@@ -266,7 +268,30 @@ func contentFromDataMessage(dataMessage *signalpb.DataMessage) *signalpb.Content
 		DataMessage: dataMessage,
 	}
 }
-func syncMessageFromGroupDataMessage(dataMessage *signalpb.DataMessage, results []SuccessfulSendResult) *signalpb.Content {
+
+// contentDataMessage returns the data message of content, which is inside the EditMessage for edits
+func contentDataMessage(content *signalpb.Content) *signalpb.DataMessage {
+	if content.EditMessage != nil {
+		return content.EditMessage.DataMessage
+	}
+	return content.DataMessage
+}
+
+// syncMessageSentFromContent makes the Sent sync message for content, which has a DataMessage or an EditMessage
+func syncMessageSentFromContent(content *signalpb.Content) *signalpb.SyncMessage_Sent {
+	dataMessage := contentDataMessage(content)
+	sent := &signalpb.SyncMessage_Sent{
+		Timestamp: dataMessage.Timestamp,
+	}
+	if content.EditMessage != nil {
+		sent.EditMessage = content.EditMessage
+	} else {
+		sent.Message = dataMessage
+	}
+	return sent
+}
+
+func syncMessageFromGroupContent(content *signalpb.Content, results []SuccessfulSendResult) *signalpb.Content {
 	unidentifiedStatuses := []*signalpb.SyncMessage_Sent_UnidentifiedDeliveryStatus{}
 	for _, result := range results {
 		unidentifiedStatuses = append(unidentifiedStatuses, &signalpb.SyncMessage_Sent_UnidentifiedDeliveryStatus{
@@ -274,40 +299,36 @@ func syncMessageFromGroupDataMessage(dataMessage *signalpb.DataMessage, results
 			Unidentified:    &result.Unidentified,
 		})
 	}
+	sent := syncMessageSentFromContent(content)
+	sent.UnidentifiedStatus = unidentifiedStatuses
 	return &signalpb.Content{
 		SyncMessage: &signalpb.SyncMessage{
-			Sent: &signalpb.SyncMessage_Sent{
-				Message:            dataMessage,
-				Timestamp:          dataMessage.Timestamp,
-				UnidentifiedStatus: unidentifiedStatuses,
-			},
+			Sent: sent,
 		},
 	}
 }
 
-func syncMessageFromSoloDataMessage(dataMessage *signalpb.DataMessage, result SuccessfulSendResult) *signalpb.Content {
+func syncMessageFromSoloContent(content *signalpb.Content, result SuccessfulSendResult) *signalpb.Content {
+	sent := syncMessageSentFromContent(content)
+	sent.DestinationUuid = &result.RecipientUuid
+	sent.UnidentifiedStatus = []*signalpb.SyncMessage_Sent_UnidentifiedDeliveryStatus{
+		{
+			DestinationUuid: &result.RecipientUuid,
+			Unidentified:    &result.Unidentified,
+		},
+	}
 	return &signalpb.Content{
 		SyncMessage: &signalpb.SyncMessage{
-			Sent: &signalpb.SyncMessage_Sent{
-				Message:         dataMessage,
-				DestinationUuid: &result.RecipientUuid,
-				Timestamp:       dataMessage.Timestamp,
-				UnidentifiedStatus: []*signalpb.SyncMessage_Sent_UnidentifiedDeliveryStatus{
-					{
-						DestinationUuid: &result.RecipientUuid,
-						Unidentified:    &result.Unidentified,
-					},
-				},
-			},
+			Sent: sent,
 		},
 	}
 }
 
-func syncMessageForContactRequest() *signalpb.Content {
+func syncMessageForRequest(requestType signalpb.SyncMessage_Request_Type) *signalpb.Content {
 	return &signalpb.Content{
 		SyncMessage: &signalpb.SyncMessage{
 			Request: &signalpb.SyncMessage_Request{
-				Type: signalpb.SyncMessage_Request_CONTACTS.Enum(),
+				Type: requestType.Enum(),
 			},
 		},
 	}
@@ -333,15 +354,38 @@ func syncMessageFromReadReceiptMessage(receiptMessage *signalpb.ReceiptMessage,
 }
 
 func sendContactSyncRequest(ctx context.Context, d *Device) error {
-	groupRequest := syncMessageForContactRequest()
+	return sendSyncRequest(ctx, d, signalpb.SyncMessage_Request_CONTACTS)
+}
+
+// sendKeysSyncRequest asks the primary device for the storage service key, which is needed to find our groups
+func sendKeysSyncRequest(ctx context.Context, d *Device) error {
+	return sendSyncRequest(ctx, d, signalpb.SyncMessage_Request_KEYS)
+}
+
+func sendSyncRequest(ctx context.Context, d *Device, requestType signalpb.SyncMessage_Request_Type) error {
+	request := syncMessageForRequest(requestType)
 	currentUnixTime := time.Now().Unix()
-	_, err := sendContent(ctx, d, d.Data.AciUuid, uint64(currentUnixTime), groupRequest, 0)
+	_, err := sendContent(ctx, d, d.Data.AciUuid, uint64(currentUnixTime), request, 0)
 	if err != nil {
-		zlog.Err(err).Msg("Failed to send contact sync request message to myself (%v)")
+		zlog.Err(err).Msgf("Failed to send %s sync request message to myself", requestType)
 	}
 	return err
 }
 
+// SendViewOnceOpen tells our other devices that view-once media was opened here, so they remove it too
+func SendViewOnceOpen(ctx context.Context, d *Device, messageSender string, messageTimestamp uint64) error {
+	content := &signalpb.Content{
+		SyncMessage: &signalpb.SyncMessage{
+			ViewOnceOpen: &signalpb.SyncMessage_ViewOnceOpen{
+				SenderUuid: proto.String(messageSender),
+				Timestamp:  proto.Uint64(messageTimestamp),
+			},
+		},
+	}
+	_, err := sendContent(ctx, d, d.Data.AciUuid, currentMessageTimestamp(), content, 0)
+	return err
+}
+
 func TypingMessage(isTyping bool) *SignalContent {
 	// Note: not handling sending to a group ATM since that will require
 	// SenderKey sending to not be terrible
@@ -381,22 +425,29 @@ func ReadReceptMessageForTimestamps(timestamps []uint64) *SignalContent {
 	}
 }
 
-func DataMessageForText(text string) *SignalContent {
+// expireTimer is the chat's disappearing message timer in seconds, or 0 if messages don't disappear
+func DataMessageForText(text string, expireTimer uint32) *SignalContent {
 	timestamp := currentMessageTimestamp()
 	dm := &signalpb.DataMessage{
 		Body:      proto.String(text),
 		Timestamp: &timestamp,
 	}
+	if expireTimer > 0 {
+		dm.ExpireTimer = proto.Uint32(expireTimer)
+	}
 	return wrapDataMessageInContent(dm)
 }
 
-func DataMessageForAttachment(attachmentPointer *AttachmentPointer, caption string) *SignalContent {
+func DataMessageForAttachment(attachmentPointer *AttachmentPointer, caption string, expireTimer uint32) *SignalContent {
 	ap := (*signalpb.AttachmentPointer)(attachmentPointer) // Cast back to signalpb, this is okay AttachmentPointer is an alias
 	timestamp := currentMessageTimestamp()
 	dm := &signalpb.DataMessage{
 		Timestamp:   &timestamp,
 		Attachments: []*signalpb.AttachmentPointer{},
 	}
+	if expireTimer > 0 {
+		dm.ExpireTimer = proto.Uint32(expireTimer)
+	}
 	if caption != "" {
 		ap.Caption = proto.String(caption)
 	}
@@ -404,6 +455,18 @@ func DataMessageForAttachment(attachmentPointer *AttachmentPointer, caption stri
 	return wrapDataMessageInContent(dm)
 }
 
+func DataMessageForContact(contact *signalpb.DataMessage_Contact, expireTimer uint32) *SignalContent {
+	timestamp := currentMessageTimestamp()
+	dm := &signalpb.DataMessage{
+		Timestamp: &timestamp,
+		Contact:   []*signalpb.DataMessage_Contact{contact},
+	}
+	if expireTimer > 0 {
+		dm.ExpireTimer = proto.Uint32(expireTimer)
+	}
+	return wrapDataMessageInContent(dm)
+}
+
 func DataMessageForReaction(reaction string, targetMessageSender string, targetMessageTimestamp uint64, removing bool) *SignalContent {
 	timestamp := currentMessageTimestamp()
 	dm := &signalpb.DataMessage{
@@ -429,6 +492,18 @@ func DataMessageForDelete(targetMessageTimestamp uint64) *SignalContent {
 	return wrapDataMessageInContent(dm)
 }
 
+// DataMessageForExpireTimerUpdate changes the disappearing message timer of a 1:1 chat (0 turns it off).
+// Groups keep their timer in the group state, see SetGroupDisappearingMessagesTimer.
+func DataMessageForExpireTimerUpdate(expireTimer uint32) *SignalContent {
+	timestamp := currentMessageTimestamp()
+	dm := &signalpb.DataMessage{
+		Timestamp:   &timestamp,
+		Flags:       proto.Uint32(uint32(signalpb.DataMessage_EXPIRATION_TIMER_UPDATE)),
+		ExpireTimer: proto.Uint32(expireTimer),
+	}
+	return wrapDataMessageInContent(dm)
+}
+
 func AddQuoteToDataMessage(content *SignalContent, quotedMessageSender string, quotedMessageTimestamp uint64) {
 	// Note: We're supposed to send the quoted message content too as a fallback,
 	// but it only seems to be necessary to quote image messages on iOS and Desktop.
@@ -442,18 +517,22 @@ func AddQuoteToDataMessage(content *SignalContent, quotedMessageSender string, q
 
 func AddMentionsToDataMessage(content *SignalContent, mentions []string) {
 	dm := content.DataMessage
-	dm.BodyRanges = []*signalpb.BodyRange{}
 	// Iterate over the body string, and add a BodyRange for each Unicode replacement character
 	bodyString := *dm.Body
 	mentionIndex := 0
-	runePosition := 0
+	// Signal counts positions in UTF-16 code units
+	utf16Position := 0
 	for i, c := range bodyString {
+		if c >= 0x10000 {
+			utf16Position++
+		}
 		if c == '\uFFFC' {
 			if mentionIndex >= len(mentions) {
 				zlog.Warn().Msgf("No mention for replacement character at position %v", i)
+				utf16Position++
 				continue
 			}
-			start := uint32(runePosition)
+			start := uint32(utf16Position)
 			length := uint32(1)
 			dm.BodyRanges = append(dm.BodyRanges, &signalpb.BodyRange{
 				Start:  &start,
@@ -464,7 +543,43 @@ func AddMentionsToDataMessage(content *SignalContent, mentions []string) {
 			})
 			mentionIndex++
 		}
-		runePosition++
+		utf16Position++
+	}
+}
+
+// AddStylesToDataMessage adds formatting like bold or spoilers to parts of the body of a message
+func AddStylesToDataMessage(content *SignalContent, styles []StyleRange) {
+	dm := content.DataMessage
+	for _, style := range styles {
+		dm.BodyRanges = append(dm.BodyRanges, &signalpb.BodyRange{
+			Start:  proto.Uint32(style.Start),
+			Length: proto.Uint32(style.Length),
+			AssociatedValue: &signalpb.BodyRange_Style_{
+				Style: style.Style,
+			},
+		})
+	}
+}
+
+// AddPreviewToDataMessage attaches a link preview, the URL should appear in the body of the message
+// for Signal clients to show it. image may be nil.
+func AddPreviewToDataMessage(content *SignalContent, url, title, description string, image *AttachmentPointer) {
+	dm := content.DataMessage
+	dm.Preview = append(dm.Preview, &signalpb.Preview{
+		Url:         proto.String(url),
+		Title:       proto.String(title),
+		Description: proto.String(description),
+		Image:       (*signalpb.AttachmentPointer)(image),
+	})
+}
+
+// EditMessageForContent turns the data message of content into an edit of the message we sent at targetMessageTimestamp
+func EditMessageForContent(content *SignalContent, targetMessageTimestamp uint64) *SignalContent {
+	return &SignalContent{
+		EditMessage: &signalpb.EditMessage{
+			TargetSentTimestamp: proto.Uint64(targetMessageTimestamp),
+			DataMessage:         content.DataMessage,
+		},
 	}
 }
 
@@ -486,57 +601,423 @@ func SendGroupMessage(ctx context.Context, device *Device, gid GroupIdentifier,
 	}
 
 	content := (*signalpb.Content)(message)
-	dataMessage := content.DataMessage
-	messageTimestamp := *dataMessage.Timestamp
-	dataMessage.GroupV2 = groupMetadataForDataMessage(*group)
+	contentDataMessage(content).GroupV2 = groupMetadataForDataMessage(*group)
+	return sendGroupDataMessage(ctx, device, group, content)
+}
+
+// sendGroupDataMessage sends content with a DataMessage (or an EditMessage) that already has its group context set
+// to all members of the group, and a sync message to our other devices.
+func sendGroupDataMessage(ctx context.Context, device *Device, group *Group, content *signalpb.Content) (*GroupMessageSendResult, error) {
+	messageTimestamp := *contentDataMessage(content).Timestamp
 
-	// Send to each member of the group
+	recipients := []string{}
+	for _, member := range group.Members {
+		if member.UserId == device.Data.AciUuid {
+			// Don't send normal DataMessages to ourselves
+			continue
+		}
+		recipients = append(recipients, member.UserId)
+	}
+	result, err := sendGroupContent(ctx, device, group.GroupIdentifier, recipients, messageTimestamp, content)
+	if err != nil {
+		return nil, err
+	}
+
+	// No need to send to ourselves if we don't have any other devices
+	if howManyOtherDevicesDoWeHave(ctx, device) > 0 {
+		syncContent := syncMessageFromGroupContent(content, result.SuccessfullySentTo)
+		_, selfSendErr := sendContent(ctx, device, device.Data.AciUuid, messageTimestamp, syncContent, 0)
+		if selfSendErr != nil {
+			zlog.Err(selfSendErr).Msg("Failed to send sync message to myself (%v)")
+		}
+	}
+
+	if len(result.SuccessfullySentTo) == 0 && len(result.FailedToSendTo) > 0 {
+		lastError := result.FailedToSendTo[len(result.FailedToSendTo)-1].Error
+		return nil, fmt.Errorf("Failed to send to any group members: %v", lastError)
+	}
+
+	return result, nil
+}
+
+// sendGroupContent sends content to the given group members, using a single sender key
+// multi-recipient message for everyone we can, and falling back to sending to each member
+// separately for the rest (e.g. members whose profile key we don't know yet).
+func sendGroupContent(ctx context.Context, device *Device, gid GroupIdentifier, recipients []string, messageTimestamp uint64, content *signalpb.Content) (*GroupMessageSendResult, error) {
 	result := &GroupMessageSendResult{
 		SuccessfullySentTo: []SuccessfulSendResult{},
 		FailedToSendTo:     []FailedSendResult{},
 	}
-	for _, member := range group.Members {
-		if member.UserId == device.Data.AciUuid {
-			// Don't send normal DataMessages to ourselves
+
+	senderKeyRecipients := map[string]*libsignalgo.AccessKey{}
+	fanoutRecipients := []string{}
+	for _, recipientUuid := range recipients {
+		accessKey, err := accessKeyForSignalID(ctx, device, recipientUuid)
+		if err != nil || accessKey == nil {
+			zlog.Debug().Msgf("No access key for %v, will send to them separately", recipientUuid)
+			fanoutRecipients = append(fanoutRecipients, recipientUuid)
 			continue
 		}
-		sentUnidentified, err := sendContent(ctx, device, member.UserId, messageTimestamp, content, 0)
+		senderKeyRecipients[recipientUuid] = accessKey
+	}
+
+	if len(senderKeyRecipients) > 0 {
+		err := rotateSenderKeyIfMembersRemoved(ctx, device, gid, recipients)
+		if err != nil {
+			return nil, err
+		}
+		sent, failed, fallback, err := sendSenderKeyContent(ctx, device, gid, senderKeyRecipients, messageTimestamp, content, 0)
+		if err != nil {
+			zlog.Err(err).Msg("Failed to send sender key message, falling back to sending to each member")
+			for recipientUuid := range senderKeyRecipients {
+				fanoutRecipients = append(fanoutRecipients, recipientUuid)
+			}
+		} else {
+			result.SuccessfullySentTo = append(result.SuccessfullySentTo, sent...)
+			result.FailedToSendTo = append(result.FailedToSendTo, failed...)
+			fanoutRecipients = append(fanoutRecipients, fallback...)
+		}
+	}
+
+	// Send to each remaining member of the group
+	for _, recipientUuid := range fanoutRecipients {
+		sentUnidentified, err := sendContent(ctx, device, recipientUuid, messageTimestamp, content, 0)
 		if err != nil {
 			result.FailedToSendTo = append(result.FailedToSendTo, FailedSendResult{
-				RecipientUuid: member.UserId,
+				RecipientUuid: recipientUuid,
 				Error:         err,
 			})
-			zlog.Err(err).Msgf("Failed to send to %v", member.UserId)
+			zlog.Err(err).Msgf("Failed to send to %v", recipientUuid)
 		} else {
 			result.SuccessfullySentTo = append(result.SuccessfullySentTo, SuccessfulSendResult{
-				RecipientUuid: member.UserId,
+				RecipientUuid: recipientUuid,
 				Unidentified:  sentUnidentified,
 			})
-			zlog.Trace().Msgf("Successfully sent to %v", member.UserId)
+			zlog.Trace().Msgf("Successfully sent to %v", recipientUuid)
 		}
 	}
+	return result, nil
+}
 
-	// No need to send to ourselves if we don't have any other devices
-	if howManyOtherDevicesDoWeHave(ctx, device) > 0 {
-		syncContent := syncMessageFromGroupDataMessage(dataMessage, result.SuccessfullySentTo)
-		_, selfSendErr := sendContent(ctx, device, device.Data.AciUuid, messageTimestamp, syncContent, 0)
-		if selfSendErr != nil {
-			zlog.Err(selfSendErr).Msg("Failed to send sync message to myself (%v)")
+// rotateSenderKeyIfMembersRemoved switches to a new sender key for the group if our current one was shared
+// with anyone who isn't a member anymore, so that removed members can't read messages sent after they left.
+func rotateSenderKeyIfMembersRemoved(ctx context.Context, device *Device, gid GroupIdentifier, members []string) error {
+	distributionID, err := device.SenderKeyStoreExtras.DistributionIDForGroup(gid, ctx)
+	if err != nil {
+		return err
+	}
+	sharedWith, err := device.SenderKeyStoreExtras.SenderKeySharedWith(distributionID, ctx)
+	if err != nil {
+		return err
+	}
+	isMember := map[string]bool{device.Data.AciUuid: true}
+	for _, member := range members {
+		isMember[member] = true
+	}
+	for theirUuid := range sharedWith {
+		if !isMember[theirUuid] {
+			zlog.Info().Msgf("Sender key for group %v was shared with %v who isn't a member anymore, rotating it", gid, theirUuid)
+			_, err = device.SenderKeyStoreExtras.RotateDistributionIDForGroup(gid, ctx)
+			return err
 		}
 	}
+	return nil
+}
 
-	if len(result.SuccessfullySentTo) == 0 {
-		lastError := result.FailedToSendTo[len(result.FailedToSendTo)-1].Error
-		return nil, fmt.Errorf("Failed to send to any group members: %v", lastError)
+func accessKeyForSignalID(ctx context.Context, d *Device, signalID string) (*libsignalgo.AccessKey, error) {
+	profileKey, err := ProfileKeyForSignalID(ctx, d, signalID)
+	if err != nil {
+		return nil, err
+	} else if profileKey == nil {
+		return nil, nil
 	}
+	return profileKey.DeriveAccessKey()
+}
 
-	return result, nil
+// ensureSenderKeyShared sends our sender key for the distribution ID to any devices of the recipient
+// that haven't received it yet, and returns the addresses of all of the recipient's devices.
+func ensureSenderKeyShared(
+	ctx context.Context,
+	d *Device,
+	recipientUuid string,
+	distributionID uuid.UUID,
+	sharedWith map[string][]uint,
+	skdmContent func() (*signalpb.Content, error),
+) ([]*libsignalgo.Address, error) {
+	addresses, sessionRecords, err := d.SessionStoreExtras.AllSessionsForUUID(recipientUuid, ctx)
+	if err == nil && (len(addresses) == 0 || len(sessionRecords) == 0) {
+		// No sessions, make one with prekey
+		FetchAndProcessPreKey(ctx, d, recipientUuid, -1)
+		addresses, sessionRecords, err = d.SessionStoreExtras.AllSessionsForUUID(recipientUuid, ctx)
+	}
+	err = checkForErrorWithSessions(err, addresses, sessionRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	needsKey := false
+	for _, address := range addresses {
+		deviceID, err := address.DeviceID()
+		if err != nil {
+			return nil, err
+		}
+		alreadyShared := false
+		for _, sharedDeviceID := range sharedWith[recipientUuid] {
+			if sharedDeviceID == deviceID {
+				alreadyShared = true
+				break
+			}
+		}
+		if !alreadyShared {
+			needsKey = true
+			break
+		}
+	}
+	if !needsKey {
+		return addresses, nil
+	}
+
+	zlog.Debug().Msgf("Sending sender key distribution message to %v", recipientUuid)
+	content, err := skdmContent()
+	if err != nil {
+		return nil, err
+	}
+	_, err = sendContent(ctx, d, recipientUuid, currentMessageTimestamp(), content, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Sending may have changed the device list, so reload it
+	addresses, sessionRecords, err = d.SessionStoreExtras.AllSessionsForUUID(recipientUuid, ctx)
+	err = checkForErrorWithSessions(err, addresses, sessionRecords)
+	if err != nil {
+		return nil, err
+	}
+	deviceIDs := make([]uint, 0, len(addresses))
+	for _, address := range addresses {
+		deviceID, err := address.DeviceID()
+		if err != nil {
+			return nil, err
+		}
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+	err = d.SenderKeyStoreExtras.MarkSenderKeySharedWith(distributionID, recipientUuid, deviceIDs, ctx)
+	if err != nil {
+		zlog.Err(err).Msg("MarkSenderKeySharedWith error")
+	}
+	return addresses, nil
+}
+
+type multiRecipient404Response struct {
+	UUIDs404 []string `json:"uuids404"`
+}
+
+type multiRecipientDevicesResponse struct {
+	UUID    string                 `json:"uuid"`
+	Devices map[string]interface{} `json:"devices"`
+}
+
+// sendSenderKeyContent encrypts the content once with our sender key for the group and sends it
+// to all recipients with a single request to /v1/messages/multi_recipient.
+// Recipients that couldn't be included (e.g. because we failed to share our sender key with them)
+// are returned in fallback, so the caller can send to them separately.
+func sendSenderKeyContent(
+	ctx context.Context,
+	d *Device,
+	gid GroupIdentifier,
+	recipients map[string]*libsignalgo.AccessKey,
+	messageTimestamp uint64,
+	content *signalpb.Content,
+	retryCount int, // For ending recursive retries
+) (sent []SuccessfulSendResult, failed []FailedSendResult, fallback []string, err error) {
+	if retryCount > 3 {
+		err := fmt.Errorf("Too many retries")
+		zlog.Err(err).Msgf("sendSenderKeyContent too many retries: %v", retryCount)
+		return nil, nil, nil, err
+	}
+
+	distributionID, err := d.SenderKeyStoreExtras.DistributionIDForGroup(gid, ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sharedWith, err := d.SenderKeyStoreExtras.SenderKeySharedWith(distributionID, ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ourAddress, err := libsignalgo.NewAddress(d.Data.AciUuid, uint(d.Data.DeviceId))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Only build the distribution message if someone actually needs it
+	var skdm *signalpb.Content
+	skdmContent := func() (*signalpb.Content, error) {
+		if skdm != nil {
+			return skdm, nil
+		}
+		distributionMessage, err := libsignalgo.NewSenderKeyDistributionMessage(
+			ourAddress,
+			distributionID,
+			d.SenderKeyStore,
+			libsignalgo.NewCallbackContext(ctx),
+		)
+		if err != nil {
+			return nil, err
+		}
+		serialized, err := distributionMessage.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		skdm = &signalpb.Content{SenderKeyDistributionMessage: serialized}
+		return skdm, nil
+	}
+
+	var addresses []*libsignalgo.Address
+	var combinedAccessKey libsignalgo.AccessKey
+	included := []string{}
+	for recipientUuid, accessKey := range recipients {
+		recipientAddresses, err := ensureSenderKeyShared(ctx, d, recipientUuid, distributionID, sharedWith, skdmContent)
+		if err != nil {
+			zlog.Err(err).Msgf("Failed to share sender key with %v, will send to them separately", recipientUuid)
+			fallback = append(fallback, recipientUuid)
+			continue
+		}
+		addresses = append(addresses, recipientAddresses...)
+		for i := range combinedAccessKey {
+			combinedAccessKey[i] ^= accessKey[i]
+		}
+		included = append(included, recipientUuid)
+	}
+	if len(included) == 0 {
+		return nil, nil, fallback, nil
+	}
+
+	// Encrypt the message once for the whole group
+	serializedMessage, err := proto.Marshal(content)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	paddedMessage, err := addPadding(3, []byte(serializedMessage)) // TODO: figure out how to get actual version
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cipherTextMessage, err := libsignalgo.GroupEncrypt(
+		paddedMessage,
+		ourAddress,
+		distributionID,
+		d.SenderKeyStore,
+		libsignalgo.NewCallbackContext(ctx),
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cert, err := senderCertificate(d)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	groupIDBytes, err := base64.StdEncoding.DecodeString(string(gid))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	usmc, err := libsignalgo.NewUnidentifiedSenderMessageContent(
+		cipherTextMessage,
+		cert,
+		libsignalgo.UnidentifiedSenderMessageContentHintResendable,
+		groupIDBytes,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	multiRecipientMessage, err := libsignalgo.SealedSenderMultiRecipientEncrypt(
+		usmc,
+		addresses,
+		d.IdentityStore,
+		d.SessionStore,
+		libsignalgo.NewCallbackContext(ctx),
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	path := fmt.Sprintf("/v1/messages/multi_recipient?ts=%d&online=false&urgent=true", messageTimestamp)
+	request := web.CreateWSRequest("PUT", path, multiRecipientMessage, nil, nil)
+	request.Headers = []string{
+		"content-type:application/vnd.signal-messenger.mrm",
+		"unidentified-access-key:" + base64.StdEncoding.EncodeToString(combinedAccessKey[:]),
+	}
+	zlog.Trace().Msgf("Sending multi-recipient message to %v recipients in %v", len(included), gid)
+	response, err := d.Connection.UnauthedWS.SendRequest(ctx, request)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	zlog.Trace().Msgf("Received a response to a multi-recipient send, id: %v, code: %v", *response.Id, *response.Status)
+
+	switch *response.Status {
+	case 200:
+		var body multiRecipient404Response
+		if len(response.Body) > 0 {
+			err = json.Unmarshal(response.Body, &body)
+			if err != nil {
+				zlog.Err(err).Msg("Unmarshal error")
+			}
+		}
+		unregistered := make(map[string]bool, len(body.UUIDs404))
+		for _, recipientUuid := range body.UUIDs404 {
+			unregistered[recipientUuid] = true
+		}
+		for _, recipientUuid := range included {
+			if unregistered[recipientUuid] {
+				failed = append(failed, FailedSendResult{
+					RecipientUuid: recipientUuid,
+					Error:         fmt.Errorf("Recipient %v is not registered", recipientUuid),
+				})
+				continue
+			}
+			sent = append(sent, SuccessfulSendResult{
+				RecipientUuid: recipientUuid,
+				Unidentified:  true,
+			})
+			d.rememberSentMessage(recipientUuid, messageTimestamp, content)
+		}
+		return sent, failed, fallback, nil
+	case 409, 410:
+		var body []multiRecipientDevicesResponse
+		err = json.Unmarshal(response.Body, &body)
+		if err != nil {
+			zlog.Err(err).Msg("Unmarshal error")
+			return nil, nil, nil, err
+		}
+		for _, entry := range body {
+			if *response.Status == 409 {
+				err = handleMismatchedDevices(ctx, d, entry.UUID, entry.Devices)
+			} else {
+				err = handleStaleDevices(ctx, d, entry.UUID, entry.Devices)
+			}
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		// Try to send again (**RECURSIVELY**), redistributing the sender key where needed
+		remaining := make(map[string]*libsignalgo.AccessKey, len(included))
+		for _, recipientUuid := range included {
+			remaining[recipientUuid] = recipients[recipientUuid]
+		}
+		sent, failed, retryFallback, err := sendSenderKeyContent(ctx, d, gid, remaining, messageTimestamp, content, retryCount+1)
+		return sent, failed, append(fallback, retryFallback...), err
+	case 401:
+		// At least one of the access keys was wrong, so send to everyone separately
+		zlog.Warn().Msg("Multi-recipient send was rejected due to an invalid access key")
+		return nil, nil, append(fallback, included...), nil
+	default:
+		err := fmt.Errorf("Unexpected status code while sending: %v", *response.Status)
+		zlog.Err(err).Msg("")
+		return nil, nil, nil, err
+	}
 }
 
 func SendMessage(ctx context.Context, device *Device, recipientUuid string, message *SignalContent) SendMessageResult {
 	// Assemble the content to send
 	content := (*signalpb.Content)(message)
-	dataMessage := content.DataMessage
+	dataMessage := contentDataMessage(content)
 	var messageTimestamp uint64
 	if dataMessage != nil {
 		messageTimestamp = *dataMessage.Timestamp
@@ -572,7 +1053,7 @@ func SendMessage(ctx context.Context, device *Device, recipientUuid string, mess
 	if howManyOtherDevicesDoWeHave(ctx, device) > 0 {
 		var syncContent *signalpb.Content
 		if dataMessage != nil {
-			syncContent = syncMessageFromSoloDataMessage(dataMessage, *result.SuccessfulSendResult)
+			syncContent = syncMessageFromSoloContent(content, *result.SuccessfulSendResult)
 		}
 		if content.ReceiptMessage != nil && *content.ReceiptMessage.Type == signalpb.ReceiptMessage_READ {
 			syncContent = syncMessageFromReadReceiptMessage(content.ReceiptMessage, recipientUuid)
@@ -710,6 +1191,9 @@ func sendContent(
 		err := fmt.Errorf("Unexpected status code while sending: %v", *response.Status)
 		zlog.Err(err).Msg("")
 		return sentUnidentified, err
+	} else {
+		// Keep it around in case they can't decrypt it and ask for it again
+		d.rememberSentMessage(recipientUuid, messageTimestamp, content)
 	}
 
 	return sentUnidentified, nil
@@ -724,6 +1208,11 @@ func handle409(ctx context.Context, device *Device, recipientUuid string, respon
 		zlog.Err(err).Msg("Unmarshal error")
 		return err
 	}
+	return handleMismatchedDevices(ctx, device, recipientUuid, body)
+}
+
+func handleMismatchedDevices(ctx context.Context, device *Device, recipientUuid string, body map[string]interface{}) error {
+	var err error
 	// check for missingDevices and extraDevices
 	if body["missingDevices"] != nil {
 		missingDevices := body["missingDevices"].([]interface{})
@@ -753,6 +1242,13 @@ func handle409(ctx context.Context, device *Device, recipientUuid string, respon
 			}
 		}
 	}
+	if body["missingDevices"] != nil || body["extraDevices"] != nil {
+		// The device list changed, so our sender keys need to be redistributed
+		err = device.SenderKeyStoreExtras.ClearSenderKeySharedWithUUID(recipientUuid, ctx)
+		if err != nil {
+			zlog.Err(err).Msg("ClearSenderKeySharedWithUUID error")
+		}
+	}
 	return err
 }
 
@@ -765,6 +1261,11 @@ func handle410(ctx context.Context, device *Device, recipientUuid string, respon
 		zlog.Err(err).Msg("Unmarshal error")
 		return err
 	}
+	return handleStaleDevices(ctx, device, recipientUuid, body)
+}
+
+func handleStaleDevices(ctx context.Context, device *Device, recipientUuid string, body map[string]interface{}) error {
+	var err error
 	// check for staleDevices and make new sessions with them
 	if body["staleDevices"] != nil {
 		staleDevices := body["staleDevices"].([]interface{})
@@ -781,6 +1282,11 @@ func handle410(ctx context.Context, device *Device, recipientUuid string, respon
 			}
 			FetchAndProcessPreKey(ctx, device, recipientUuid, int(staleDevice.(float64)))
 		}
+		// Stale devices have lost our sender keys, so they need to be redistributed
+		err = device.SenderKeyStoreExtras.ClearSenderKeySharedWithUUID(recipientUuid, ctx)
+		if err != nil {
+			zlog.Err(err).Msg("ClearSenderKeySharedWithUUID error")
+		}
 	}
 	return err
 }
@@ -0,0 +1,242 @@
+package signalmeow
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"go.mau.fi/mautrix-signal/pkg/libsignalgo"
+	"go.mau.fi/mautrix-signal/pkg/signalmeow/web"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The storage service protobufs aren't in our copy of the Signal protobufs,
+// so the few fields needed to find groups are decoded by hand.
+const (
+	storageIdentifierTypeGroupV2 = 3
+	storageRecordGroupV2Field    = 3
+	storageReadBatchSize         = 1000
+)
+
+type storageCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func fetchStorageCredentials(ctx context.Context, d *Device) (*storageCredentials, error) {
+	authRequest := web.CreateWSRequest("GET", "/v1/storage/auth", nil, nil, nil)
+	resp, err := d.Connection.AuthedWS.SendRequest(ctx, authRequest)
+	if err != nil {
+		return nil, err
+	}
+	if *resp.Status != 200 {
+		return nil, fmt.Errorf("bad status code fetching storage credentials: %d", *resp.Status)
+	}
+	var creds storageCredentials
+	err = json.Unmarshal(resp.Body, &creds)
+	if err != nil {
+		return nil, err
+	}
+	return &creds, nil
+}
+
+func sendStorageRequest(method string, path string, creds *storageCredentials, body []byte) ([]byte, int, error) {
+	opts := &web.HTTPReqOpt{
+		Username:    &creds.Username,
+		Password:    &creds.Password,
+		ContentType: web.ContentTypeProtobuf,
+		Host:        web.StorageUrlHost,
+		Body:        body,
+	}
+	resp, err := web.SendHTTPRequest(method, path, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	return respBody, resp.StatusCode, err
+}
+
+// decryptStorageData decrypts the manifest or a record with the key derived from the storage key for its name
+func decryptStorageData(storageKey []byte, keyName string, data []byte) ([]byte, error) {
+	mac := hmac.New(sha256.New, storageKey)
+	mac.Write([]byte(keyName))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize()+gcm.Overhead() {
+		return nil, errors.New("storage data too short")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+// decodeProtoFields calls handle for every bytes and varint field of a protobuf message
+func decodeProtoFields(b []byte, handle func(num protowire.Number, value []byte, varint uint64)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch typ {
+		case protowire.BytesType:
+			value, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			handle(num, value, 0)
+			b = b[n:]
+		case protowire.VarintType:
+			varint, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			handle(num, nil, varint)
+			b = b[n:]
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+	return nil
+}
+
+// FetchGroupsFromStorage stores the master keys of the groups in the account's storage service data and
+// returns their identifiers. Groups otherwise only become known when something happens in them,
+// so this is how they're found right after logging in. The storage key comes from the keys sync message.
+func FetchGroupsFromStorage(ctx context.Context, d *Device, storageKey []byte) ([]GroupIdentifier, error) {
+	creds, err := fetchStorageCredentials(ctx, d)
+	if err != nil {
+		zlog.Err(err).Msg("fetchStorageCredentials error")
+		return nil, err
+	}
+
+	manifestBytes, status, err := sendStorageRequest("GET", "/v1/storage/manifest", creds, nil)
+	if err != nil {
+		zlog.Err(err).Msg("Fetching storage manifest error")
+		return nil, err
+	} else if status == 404 {
+		// Nothing has been stored for the account yet
+		return nil, nil
+	} else if status != 200 {
+		return nil, fmt.Errorf("bad status code fetching storage manifest: %d", status)
+	}
+	var manifestVersion uint64
+	var encryptedManifest []byte
+	err = decodeProtoFields(manifestBytes, func(num protowire.Number, value []byte, varint uint64) {
+		switch num {
+		case 1:
+			manifestVersion = varint
+		case 2:
+			encryptedManifest = value
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode storage manifest: %w", err)
+	}
+	manifest, err := decryptStorageData(storageKey, fmt.Sprintf("Manifest_%d", manifestVersion), encryptedManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt storage manifest: %w", err)
+	}
+	var groupRecordIDs [][]byte
+	err = decodeProtoFields(manifest, func(num protowire.Number, identifier []byte, _ uint64) {
+		if num != 2 {
+			return
+		}
+		var raw []byte
+		var identifierType uint64
+		_ = decodeProtoFields(identifier, func(num protowire.Number, value []byte, varint uint64) {
+			switch num {
+			case 1:
+				raw = value
+			case 2:
+				identifierType = varint
+			}
+		})
+		if identifierType == storageIdentifierTypeGroupV2 && len(raw) > 0 {
+			groupRecordIDs = append(groupRecordIDs, raw)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode storage manifest record: %w", err)
+	}
+	zlog.Debug().Msgf("Storage manifest version %d has %d groups", manifestVersion, len(groupRecordIDs))
+
+	var groupIdentifiers []GroupIdentifier
+	for start := 0; start < len(groupRecordIDs); start += storageReadBatchSize {
+		end := start + storageReadBatchSize
+		if end > len(groupRecordIDs) {
+			end = len(groupRecordIDs)
+		}
+		var readOperation []byte
+		for _, recordID := range groupRecordIDs[start:end] {
+			readOperation = protowire.AppendTag(readOperation, 1, protowire.BytesType)
+			readOperation = protowire.AppendBytes(readOperation, recordID)
+		}
+		itemsBytes, status, err := sendStorageRequest("PUT", "/v1/storage/read", creds, readOperation)
+		if err != nil {
+			zlog.Err(err).Msg("Reading storage records error")
+			return groupIdentifiers, err
+		} else if status != 200 {
+			return groupIdentifiers, fmt.Errorf("bad status code reading storage records: %d", status)
+		}
+		err = decodeProtoFields(itemsBytes, func(num protowire.Number, item []byte, _ uint64) {
+			if num != 1 {
+				return
+			}
+			var key, encryptedRecord []byte
+			_ = decodeProtoFields(item, func(num protowire.Number, value []byte, _ uint64) {
+				switch num {
+				case 1:
+					key = value
+				case 2:
+					encryptedRecord = value
+				}
+			})
+			record, err := decryptStorageData(storageKey, "Item_"+base64.StdEncoding.EncodeToString(key), encryptedRecord)
+			if err != nil {
+				zlog.Err(err).Msg("Failed to decrypt storage record")
+				return
+			}
+			var masterKey []byte
+			_ = decodeProtoFields(record, func(num protowire.Number, groupRecord []byte, _ uint64) {
+				if num != storageRecordGroupV2Field {
+					return
+				}
+				_ = decodeProtoFields(groupRecord, func(num protowire.Number, value []byte, _ uint64) {
+					if num == 1 {
+						masterKey = value
+					}
+				})
+			})
+			if len(masterKey) != len(libsignalgo.GroupMasterKey{}) {
+				zlog.Warn().Msg("Storage group record doesn't have a valid master key")
+				return
+			}
+			gid, err := StoreMasterKey(ctx, d, masterKeyFromBytes(libsignalgo.GroupMasterKey(masterKey)))
+			if err != nil {
+				return
+			}
+			groupIdentifiers = append(groupIdentifiers, gid)
+		})
+		if err != nil {
+			return groupIdentifiers, fmt.Errorf("failed to decode storage records: %w", err)
+		}
+	}
+	return groupIdentifiers, nil
+}
@@ -42,10 +42,12 @@ type Device struct {
 	SenderKeyStore    libsignalgo.SenderKeyStore
 
 	// internal store interfaces
-	PreKeyStoreExtras  PreKeyStoreExtras
-	SessionStoreExtras SessionStoreExtras
-	ProfileKeyStore    ProfileKeyStore
-	GroupStore         GroupStore
+	PreKeyStoreExtras    PreKeyStoreExtras
+	SessionStoreExtras   SessionStoreExtras
+	SenderKeyStoreExtras SenderKeyStoreExtras
+	IdentityStoreExtras  IdentityStoreExtras
+	ProfileKeyStore      ProfileKeyStore
+	GroupStore           GroupStore
 }
 
 // New connects to the given SQL database and wraps it in a StoreContainer.
@@ -126,10 +128,12 @@ func (c *StoreContainer) scanDevice(row scannable) (*Device, error) {
 	device.SignedPreKeyStore = innerStore
 	device.KyberPreKeyStore = innerStore
 	device.IdentityStore = innerStore
+	device.IdentityStoreExtras = innerStore
 	device.SessionStore = innerStore
 	device.SessionStoreExtras = innerStore
 	device.ProfileKeyStore = innerStore
 	device.SenderKeyStore = innerStore
+	device.SenderKeyStoreExtras = innerStore
 	device.GroupStore = innerStore
 
 	return &device, nil
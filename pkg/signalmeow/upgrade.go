@@ -10,7 +10,7 @@ type upgradeFunc func(*sql.Tx, *StoreContainer) error
 //
 // This may be of use if you want to manage the database fully manually, but in most cases you
 // should just call StoreContainer.Upgrade to let the library handle everything.
-var Upgrades = [...]upgradeFunc{upgradeV1, upgradeV2}
+var Upgrades = [...]upgradeFunc{upgradeV1, upgradeV2, upgradeV3}
 
 func (c *StoreContainer) getVersion() (int, error) {
 	_, err := c.db.Exec("CREATE TABLE IF NOT EXISTS signalmeow_version (version INTEGER)")
@@ -169,3 +169,28 @@ func upgradeV2(tx *sql.Tx, _ *StoreContainer) error {
 	}
 	return nil
 }
+
+func upgradeV3(tx *sql.Tx, _ *StoreContainer) error {
+	_, err := tx.Exec(`CREATE TABLE signalmeow_sender_key_distributions (
+		our_aci_uuid        TEXT    NOT NULL,
+		group_identifier    TEXT    NOT NULL,
+		distribution_id     TEXT    NOT NULL,
+
+		PRIMARY KEY (our_aci_uuid, group_identifier)
+	)`)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`CREATE TABLE signalmeow_sender_key_shared (
+		our_aci_uuid        TEXT    NOT NULL,
+		distribution_id     TEXT    NOT NULL,
+		their_aci_uuid      TEXT    NOT NULL,
+		their_device_id     INTEGER NOT NULL,
+
+		PRIMARY KEY (our_aci_uuid, distribution_id, their_aci_uuid, their_device_id)
+	)`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package web
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Resumable uploads with the tus protocol (https://tus.io/protocols/resumable-upload), which is what CDN3 speaks
+
+const tusVersion = "1.0.0"
+
+// TUSChunkSize is the amount of bytes sent in a single PATCH request. After a failed request, the upload
+// resumes from whatever the server received, so at most one chunk has to be sent again.
+var TUSChunkSize int64 = 16 * 1024 * 1024
+
+// TUSMaxRetries is how many failed requests in a row are tolerated before giving up on an upload
+var TUSMaxRetries = 5
+
+var tusRetryDelay = 2 * time.Second
+
+var ErrTUSUploadFailed = errors.New("resumable upload failed")
+
+func tusHeaders(headers map[string]string, extra ...string) map[string]string {
+	merged := make(map[string]string, len(headers)+len(extra)/2+1)
+	for k, v := range headers {
+		merged[k] = v
+	}
+	merged["Tus-Resumable"] = tusVersion
+	for i := 0; i+1 < len(extra); i += 2 {
+		merged[extra[i]] = extra[i+1]
+	}
+	return merged
+}
+
+// TUSUpload creates an upload for key at location (the signed upload location of an upload form) and sends
+// the size bytes of body to it in chunks. If a chunk fails, the offset the server got to is fetched
+// with a HEAD request and the upload continues from there.
+func TUSUpload(location, key string, headers map[string]string, body io.ReadSeeker, size int64) error {
+	uploadURL, err := tusCreate(location, key, headers, size)
+	if err != nil {
+		return err
+	}
+
+	var offset int64
+	failures := 0
+	for offset < size {
+		var newOffset int64
+		newOffset, err = tusPatch(uploadURL, headers, body, offset, size)
+		if err == nil {
+			failures = 0
+			offset = newOffset
+			continue
+		}
+		failures++
+		if failures > TUSMaxRetries {
+			return fmt.Errorf("%w after %d retries: %v", ErrTUSUploadFailed, TUSMaxRetries, err)
+		}
+		zlog.Warn().Err(err).Msgf("Uploading chunk at offset %d failed, resuming upload", offset)
+		time.Sleep(tusRetryDelay * time.Duration(failures))
+		newOffset, err = tusHead(uploadURL, headers, size)
+		if err != nil {
+			// The next PATCH will most likely fail too and we'll ask for the offset again
+			zlog.Warn().Err(err).Msg("Failed to get offset of resumable upload")
+			continue
+		}
+		offset = newOffset
+	}
+	return nil
+}
+
+// tusCreate announces a new upload to the server and returns the URL to send its contents to
+func tusCreate(location, key string, headers map[string]string, size int64) (string, error) {
+	resp, err := SendHTTPRequest(http.MethodPost, "", &HTTPReqOpt{
+		OverrideURL: location,
+		ContentType: ContentTypeOctetStream,
+		Headers: tusHeaders(headers,
+			"Upload-Length", strconv.FormatInt(size, 10),
+			"Upload-Metadata", "filename "+base64.StdEncoding.EncodeToString([]byte(key)),
+		),
+	})
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("%w: unexpected status creating upload: %s", ErrTUSUploadFailed, resp.Status)
+	}
+	base, err := url.Parse(location)
+	if err != nil {
+		return "", err
+	}
+	uploadLocation := resp.Header.Get("Location")
+	if uploadLocation == "" {
+		// The upload is named after the key if the server doesn't say otherwise
+		return base.JoinPath(key).String(), nil
+	}
+	uploadURL, err := base.Parse(uploadLocation)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid upload location: %v", ErrTUSUploadFailed, err)
+	}
+	return uploadURL.String(), nil
+}
+
+// tusPatch sends the chunk of body starting at offset and returns the offset the server has after it
+func tusPatch(uploadURL string, headers map[string]string, body io.ReadSeeker, offset, size int64) (int64, error) {
+	length := size - offset
+	if length > TUSChunkSize {
+		length = TUSChunkSize
+	}
+	if _, err := body.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	resp, err := SendHTTPRequest(http.MethodPatch, "", &HTTPReqOpt{
+		OverrideURL: uploadURL,
+		BodyStream:  io.LimitReader(body, length),
+		BodyLength:  length,
+		ContentType: ContentTypeOffsetOctetStream,
+		Headers:     tusHeaders(headers, "Upload-Offset", strconv.FormatInt(offset, 10)),
+	})
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, fmt.Errorf("unexpected status uploading chunk: %s", resp.Status)
+	}
+	newOffset, err := parseUploadOffset(resp, size)
+	if err != nil {
+		return 0, err
+	} else if newOffset <= offset {
+		return 0, fmt.Errorf("upload offset didn't advance from %d", offset)
+	}
+	return newOffset, nil
+}
+
+// tusHead asks the server how much of an upload it has received
+func tusHead(uploadURL string, headers map[string]string, size int64) (int64, error) {
+	resp, err := SendHTTPRequest(http.MethodHead, "", &HTTPReqOpt{
+		OverrideURL: uploadURL,
+		ContentType: ContentTypeOctetStream,
+		Headers:     tusHeaders(headers),
+	})
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, fmt.Errorf("unexpected status getting upload offset: %s", resp.Status)
+	}
+	return parseUploadOffset(resp, size)
+}
+
+func parseUploadOffset(resp *http.Response, size int64) (int64, error) {
+	offset, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Upload-Offset header: %w", err)
+	} else if offset < 0 || offset > size {
+		return 0, fmt.Errorf("upload offset %d out of range", offset)
+	}
+	return offset, nil
+}
@@ -0,0 +1,173 @@
+package web
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestMain(m *testing.M) {
+	SetLogger(zerolog.Nop())
+	os.Exit(m.Run())
+}
+
+// fakeCDN is a minimal tus server that drops the connection in the middle of one PATCH request
+type fakeCDN struct {
+	lock     sync.Mutex
+	uploads  map[string]*bytes.Buffer
+	lengths  map[string]int64
+	dropAt   int64 // drop the connection once a PATCH starting here got dropAfter bytes
+	dropped  bool
+	heads    int
+	patches  int
+	authSeen bool
+}
+
+const dropAfter = 300
+
+func (cdn *fakeCDN) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cdn.lock.Lock()
+	defer cdn.lock.Unlock()
+	if r.Header.Get("Tus-Resumable") != tusVersion {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return
+	}
+	cdn.authSeen = r.Header.Get("Authorization") == "Basic dGVzdA=="
+	switch r.Method {
+	case http.MethodPost:
+		name, encodedKey, _ := strings.Cut(r.Header.Get("Upload-Metadata"), " ")
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		length, lengthErr := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+		if name != "filename" || err != nil || lengthErr != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		cdn.uploads[string(key)] = &bytes.Buffer{}
+		cdn.lengths[string(key)] = length
+		w.Header().Set("Location", "/attachments/"+string(key))
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodHead:
+		cdn.heads++
+		upload, ok := cdn.uploads[strings.TrimPrefix(r.URL.Path, "/attachments/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Upload-Offset", strconv.Itoa(upload.Len()))
+		w.WriteHeader(http.StatusOK)
+	case http.MethodPatch:
+		cdn.patches++
+		key := strings.TrimPrefix(r.URL.Path, "/attachments/")
+		upload, ok := cdn.uploads[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+		if err != nil || offset != int64(upload.Len()) {
+			w.WriteHeader(http.StatusConflict)
+			return
+		} else if r.Header.Get("Content-Type") != string(ContentTypeOffsetOctetStream) {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		if offset == cdn.dropAt && !cdn.dropped {
+			cdn.dropped = true
+			_, _ = io.CopyN(upload, r.Body, dropAfter)
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				_ = conn.Close()
+			}
+			return
+		}
+		_, _ = io.Copy(upload, r.Body)
+		if int64(upload.Len()) > cdn.lengths[key] {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.Header().Set("Upload-Offset", strconv.Itoa(upload.Len()))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestTUSUploadResumesAfterDroppedConnection(t *testing.T) {
+	prevChunkSize, prevRetryDelay := TUSChunkSize, tusRetryDelay
+	TUSChunkSize, tusRetryDelay = 1000, 0
+	defer func() {
+		TUSChunkSize, tusRetryDelay = prevChunkSize, prevRetryDelay
+	}()
+
+	cdn := &fakeCDN{
+		uploads: make(map[string]*bytes.Buffer),
+		lengths: make(map[string]int64),
+		dropAt:  1000,
+	}
+	server := httptest.NewServer(cdn)
+	defer server.Close()
+
+	data := make([]byte, 4500)
+	_, _ = rand.Read(data)
+	headers := map[string]string{"Authorization": "Basic dGVzdA=="}
+	err := TUSUpload(server.URL+"/attachments", "abcdef", headers, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("upload failed: %v", err)
+	}
+
+	cdn.lock.Lock()
+	defer cdn.lock.Unlock()
+	if !cdn.dropped {
+		t.Fatal("connection was never dropped")
+	} else if cdn.heads != 1 {
+		t.Errorf("expected 1 HEAD request to resume the upload, got %d", cdn.heads)
+	} else if !cdn.authSeen {
+		t.Error("headers from the upload form weren't sent")
+	}
+	// 5 chunks plus one resend of the part of the second chunk that didn't make it
+	if cdn.patches != 6 {
+		t.Errorf("expected 6 PATCH requests, got %d", cdn.patches)
+	}
+	if !bytes.Equal(cdn.uploads["abcdef"].Bytes(), data) {
+		t.Error("uploaded data doesn't match")
+	}
+}
+
+func TestTUSUploadGivesUp(t *testing.T) {
+	prevRetryDelay := tusRetryDelay
+	tusRetryDelay = 0
+	defer func() {
+		tusRetryDelay = prevRetryDelay
+	}()
+
+	var patches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodHead:
+			w.Header().Set("Upload-Offset", "0")
+		case http.MethodPatch:
+			patches++
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	err := TUSUpload(server.URL+"/attachments", "abcdef", nil, bytes.NewReader([]byte("data")), 4)
+	if err == nil {
+		t.Fatal("upload didn't fail")
+	} else if patches != TUSMaxRetries+1 {
+		t.Errorf("expected %d PATCH requests, got %d", TUSMaxRetries+1, patches)
+	}
+}
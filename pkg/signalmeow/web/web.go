@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -22,12 +23,14 @@ const (
 	StorageUrlHost = "storage.signal.org"
 	CDNUrlHost     = "cdn.signal.org"
 	CDN2UrlHost    = "cdn2.signal.org"
+	CDN3UrlHost    = "cdn3.signal.org"
 )
 
 var CDNHosts = []string{
 	CDNUrlHost,
 	CDNUrlHost,
 	CDN2UrlHost,
+	CDN3UrlHost,
 }
 
 // logging
@@ -84,10 +87,14 @@ const (
 	ContentTypeJSON        ContentType = "application/json"
 	ContentTypeProtobuf    ContentType = "application/x-protobuf"
 	ContentTypeOctetStream ContentType = "application/octet-stream"
+
+	ContentTypeOffsetOctetStream ContentType = "application/offset+octet-stream"
 )
 
 type HTTPReqOpt struct {
 	Body        []byte
+	BodyStream  io.Reader // Sent instead of Body if set, BodyLength must be set as well
+	BodyLength  int64
 	Username    *string
 	Password    *string
 	ContentType ContentType
@@ -114,7 +121,13 @@ func SendHTTPRequest(method string, path string, opt *HTTPReqOpt) (*http.Respons
 		urlStr = opt.OverrideURL
 	}
 
-	req, err := http.NewRequest(method, urlStr, bytes.NewBuffer(opt.Body))
+	var body io.Reader = bytes.NewBuffer(opt.Body)
+	contentLength := int64(len(opt.Body))
+	if opt.BodyStream != nil {
+		body = opt.BodyStream
+		contentLength = opt.BodyLength
+	}
+	req, err := http.NewRequest(method, urlStr, body)
 	if err != nil {
 		zlog.Err(err).Msg("Error creating request")
 		return nil, err
@@ -129,7 +142,8 @@ func SendHTTPRequest(method string, path string, opt *HTTPReqOpt) (*http.Respons
 	} else {
 		req.Header.Set("Content-Type", string(ContentTypeJSON))
 	}
-	req.Header.Set("Content-Length", fmt.Sprintf("%d", len(opt.Body)))
+	req.ContentLength = contentLength
+	req.Header.Set("Content-Length", fmt.Sprintf("%d", contentLength))
 	// TODO: figure out what user agent to use
 	//req.Header.Set("User-Agent", "SignalBridge/0.1")
 	//req.Header.Set("X-Signal-Agent", "SignalBridge/0.1")
@@ -181,7 +195,7 @@ func GetAttachment(path string, cdnNumber uint32, opt *HTTPReqOpt) (*http.Respon
 			// This is basically a fallback if cdnNumber is not set
 			// but it also seems to be the right host if cdnNumber == 0
 			opt.Host = CDNHosts[0]
-		} else if cdnNumber > 0 && int(cdnNumber) <= len(CDNHosts) {
+		} else if cdnNumber > 0 && int(cdnNumber) < len(CDNHosts) {
 			// Pull CDN hosts from array (cdnNumber is 1-indexed, but we have a placeholder host at index 0)
 			// (the 1-indexed is just an assumption, other clients seem to only explicitly handle cdnNumber == 0 and 2)
 			opt.Host = CDNHosts[cdnNumber]
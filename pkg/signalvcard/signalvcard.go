@@ -0,0 +1,395 @@
+// Package signalvcard converts contacts shared in Signal to vCards and back.
+package signalvcard
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+)
+
+const MimeType = "text/vcard"
+
+var ErrNoVCard = errors.New("no vCard found")
+
+// DisplayName returns the name to show for a shared contact
+func DisplayName(contact *signalpb.DataMessage_Contact) string {
+	name := contact.GetName()
+	if name.GetDisplayName() != "" {
+		return name.GetDisplayName()
+	}
+	parts := make([]string, 0, 5)
+	for _, part := range []string{name.GetPrefix(), name.GetGivenName(), name.GetMiddleName(), name.GetFamilyName(), name.GetSuffix()} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) > 0 {
+		return strings.Join(parts, " ")
+	} else if contact.GetOrganization() != "" {
+		return contact.GetOrganization()
+	} else if len(contact.GetNumber()) > 0 {
+		return contact.GetNumber()[0].GetValue()
+	} else if len(contact.GetEmail()) > 0 {
+		return contact.GetEmail()[0].GetValue()
+	}
+	return "Unnamed contact"
+}
+
+// TextFallback describes a shared contact in plain text, for clients that don't open vCards
+func TextFallback(contact *signalpb.DataMessage_Contact) string {
+	var buf strings.Builder
+	buf.WriteString("Contact: ")
+	buf.WriteString(DisplayName(contact))
+	if contact.GetOrganization() != "" && contact.GetOrganization() != DisplayName(contact) {
+		fmt.Fprintf(&buf, "\nOrganization: %s", contact.GetOrganization())
+	}
+	for _, number := range contact.GetNumber() {
+		fmt.Fprintf(&buf, "\nPhone: %s%s", number.GetValue(), typeSuffix(number.GetType().String(), number.GetLabel()))
+	}
+	for _, email := range contact.GetEmail() {
+		fmt.Fprintf(&buf, "\nEmail: %s%s", email.GetValue(), typeSuffix(email.GetType().String(), email.GetLabel()))
+	}
+	for _, address := range contact.GetAddress() {
+		fmt.Fprintf(&buf, "\nAddress: %s%s", formatAddress(address), typeSuffix(address.GetType().String(), address.GetLabel()))
+	}
+	return buf.String()
+}
+
+func typeSuffix(typeName, label string) string {
+	if label != "" {
+		return " (" + label + ")"
+	} else if typeName == "" || typeName == "CUSTOM" {
+		return ""
+	}
+	return " (" + strings.ToLower(typeName) + ")"
+}
+
+func formatAddress(address *signalpb.DataMessage_Contact_PostalAddress) string {
+	parts := make([]string, 0, 7)
+	for _, part := range []string{
+		address.GetStreet(), address.GetPobox(), address.GetNeighborhood(), address.GetCity(),
+		address.GetRegion(), address.GetPostcode(), address.GetCountry(),
+	} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+var phoneTypes = map[signalpb.DataMessage_Contact_Phone_Type]string{
+	signalpb.DataMessage_Contact_Phone_HOME:   "HOME",
+	signalpb.DataMessage_Contact_Phone_MOBILE: "CELL",
+	signalpb.DataMessage_Contact_Phone_WORK:   "WORK",
+}
+
+var emailTypes = map[signalpb.DataMessage_Contact_Email_Type]string{
+	signalpb.DataMessage_Contact_Email_HOME: "HOME",
+	signalpb.DataMessage_Contact_Email_WORK: "WORK",
+}
+
+var addressTypes = map[signalpb.DataMessage_Contact_PostalAddress_Type]string{
+	signalpb.DataMessage_Contact_PostalAddress_HOME: "HOME",
+	signalpb.DataMessage_Contact_PostalAddress_WORK: "WORK",
+}
+
+// Format encodes a shared contact as a vCard 3.0. avatar is the downloaded avatar of the contact, or nil.
+func Format(contact *signalpb.DataMessage_Contact, avatar []byte, avatarMimeType string) []byte {
+	var buf bytes.Buffer
+	writeLine(&buf, "BEGIN", "", "VCARD")
+	writeLine(&buf, "VERSION", "", "3.0")
+	writeLine(&buf, "FN", "", escape(DisplayName(contact)))
+	name := contact.GetName()
+	writeLine(&buf, "N", "", joinEscaped(name.GetFamilyName(), name.GetGivenName(), name.GetMiddleName(), name.GetPrefix(), name.GetSuffix()))
+	if contact.GetOrganization() != "" {
+		writeLine(&buf, "ORG", "", escape(contact.GetOrganization()))
+	}
+	for _, number := range contact.GetNumber() {
+		writeLine(&buf, "TEL", typeParam(phoneTypes[number.GetType()]), escape(number.GetValue()))
+	}
+	for _, email := range contact.GetEmail() {
+		writeLine(&buf, "EMAIL", typeParam(emailTypes[email.GetType()]), escape(email.GetValue()))
+	}
+	for _, address := range contact.GetAddress() {
+		writeLine(&buf, "ADR", typeParam(addressTypes[address.GetType()]), joinEscaped(
+			address.GetPobox(), address.GetNeighborhood(), address.GetStreet(), address.GetCity(),
+			address.GetRegion(), address.GetPostcode(), address.GetCountry(),
+		))
+	}
+	if len(avatar) > 0 {
+		params := ";ENCODING=b"
+		if imageType, ok := strings.CutPrefix(avatarMimeType, "image/"); ok {
+			params += ";TYPE=" + strings.ToUpper(imageType)
+		}
+		writeLine(&buf, "PHOTO", params, base64.StdEncoding.EncodeToString(avatar))
+	}
+	writeLine(&buf, "END", "", "VCARD")
+	return buf.Bytes()
+}
+
+func typeParam(vcardType string) string {
+	if vcardType == "" {
+		return ""
+	}
+	return ";TYPE=" + vcardType
+}
+
+// writeLine writes a content line, folded to 75 octets as required by RFC 2425
+func writeLine(buf *bytes.Buffer, name, params, value string) {
+	line := name + params + ":" + value
+	// Continuation lines start with a space, which counts towards the limit
+	maxLength := 75
+	for len(line) > maxLength {
+		cut := maxLength
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		maxLength = 74
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, ";", `\;`, ",", `\,`)
+
+func escape(value string) string {
+	return escaper.Replace(strings.ReplaceAll(value, "\r\n", "\n"))
+}
+
+func joinEscaped(parts ...string) string {
+	for i, part := range parts {
+		parts[i] = escape(part)
+	}
+	return strings.Join(parts, ";")
+}
+
+// Parse reads the first vCard in data into a Signal contact. The photo of the vCard is returned separately,
+// as it has to be uploaded as an attachment before sending.
+func Parse(data []byte) (contact *signalpb.DataMessage_Contact, avatar []byte, err error) {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	// Unfold continuation lines
+	text = strings.NewReplacer("\n ", "", "\n\t", "").Replace(text)
+	inCard := false
+	contact = &signalpb.DataMessage_Contact{}
+	for _, line := range strings.Split(text, "\n") {
+		name, params, value, ok := splitLine(line)
+		if !ok {
+			continue
+		}
+		if !inCard {
+			inCard = name == "BEGIN" && strings.EqualFold(value, "VCARD")
+			continue
+		}
+		types := params["TYPE"]
+		switch name {
+		case "END":
+			if contact.Name == nil && contact.Organization == nil && len(contact.Number) == 0 && len(contact.Email) == 0 {
+				return nil, nil, fmt.Errorf("%w: vCard has no name or contact information", ErrNoVCard)
+			}
+			return contact, avatar, nil
+		case "FN":
+			if contact.Name == nil {
+				contact.Name = &signalpb.DataMessage_Contact_Name{}
+			}
+			contact.Name.DisplayName = nonEmpty(unescape(value))
+		case "N":
+			if contact.Name == nil {
+				contact.Name = &signalpb.DataMessage_Contact_Name{}
+			}
+			parts := splitStructured(value, 5)
+			contact.Name.FamilyName = nonEmpty(parts[0])
+			contact.Name.GivenName = nonEmpty(parts[1])
+			contact.Name.MiddleName = nonEmpty(parts[2])
+			contact.Name.Prefix = nonEmpty(parts[3])
+			contact.Name.Suffix = nonEmpty(parts[4])
+		case "ORG":
+			contact.Organization = nonEmpty(strings.Join(filterEmpty(splitStructured(value, 1)), ", "))
+		case "TEL":
+			value = strings.TrimPrefix(unescape(value), "tel:")
+			if value == "" {
+				continue
+			}
+			phoneType := signalpb.DataMessage_Contact_Phone_MOBILE
+			if hasType(types, "HOME") {
+				phoneType = signalpb.DataMessage_Contact_Phone_HOME
+			} else if hasType(types, "WORK") {
+				phoneType = signalpb.DataMessage_Contact_Phone_WORK
+			}
+			contact.Number = append(contact.Number, &signalpb.DataMessage_Contact_Phone{
+				Value: proto.String(value),
+				Type:  phoneType.Enum(),
+			})
+		case "EMAIL":
+			value = unescape(value)
+			if value == "" {
+				continue
+			}
+			emailType := signalpb.DataMessage_Contact_Email_HOME
+			if hasType(types, "WORK") {
+				emailType = signalpb.DataMessage_Contact_Email_WORK
+			}
+			contact.Email = append(contact.Email, &signalpb.DataMessage_Contact_Email{
+				Value: proto.String(value),
+				Type:  emailType.Enum(),
+			})
+		case "ADR":
+			parts := splitStructured(value, 7)
+			addressType := signalpb.DataMessage_Contact_PostalAddress_HOME
+			if hasType(types, "WORK") {
+				addressType = signalpb.DataMessage_Contact_PostalAddress_WORK
+			}
+			contact.Address = append(contact.Address, &signalpb.DataMessage_Contact_PostalAddress{
+				Type:         addressType.Enum(),
+				Pobox:        nonEmpty(parts[0]),
+				Neighborhood: nonEmpty(parts[1]),
+				Street:       nonEmpty(parts[2]),
+				City:         nonEmpty(parts[3]),
+				Region:       nonEmpty(parts[4]),
+				Postcode:     nonEmpty(parts[5]),
+				Country:      nonEmpty(parts[6]),
+			})
+		case "PHOTO":
+			avatar = parsePhoto(params, value)
+		}
+	}
+	return nil, nil, ErrNoVCard
+}
+
+func parsePhoto(params map[string][]string, value string) []byte {
+	var encoded string
+	if hasType(params["ENCODING"], "B") || hasType(params["ENCODING"], "BASE64") || hasType(params[""], "BASE64") {
+		encoded = value
+	} else if strings.HasPrefix(value, "data:") {
+		// vCard 4.0 uses data URIs for embedded photos
+		_, after, found := strings.Cut(value, ";base64,")
+		if !found {
+			return nil
+		}
+		encoded = after
+	} else {
+		return nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+	if err != nil {
+		return nil
+	}
+	return decoded
+}
+
+// splitLine splits a content line into the uppercase property name (without group), the parameters and the value.
+// Parameters without a name (like vCard 2.1's TEL;CELL:...) are stored under the empty key.
+func splitLine(line string) (name string, params map[string][]string, value string, ok bool) {
+	inQuotes := false
+	colon := -1
+	for i := 0; i < len(line) && colon < 0; i++ {
+		switch line[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case ':':
+			if !inQuotes {
+				colon = i
+			}
+		}
+	}
+	if colon < 0 {
+		return "", nil, "", false
+	}
+	value = line[colon+1:]
+	nameAndParams := strings.Split(line[:colon], ";")
+	name = strings.ToUpper(nameAndParams[0])
+	if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+		name = name[dot+1:]
+	}
+	params = make(map[string][]string)
+	for _, param := range nameAndParams[1:] {
+		key, paramValue, found := strings.Cut(param, "=")
+		if !found {
+			key, paramValue = "", param
+		}
+		key = strings.ToUpper(key)
+		for _, item := range strings.Split(paramValue, ",") {
+			params[key] = append(params[key], strings.ToUpper(strings.Trim(item, `"`)))
+		}
+	}
+	if bareTypes, ok := params[""]; ok {
+		params["TYPE"] = append(params["TYPE"], bareTypes...)
+	}
+	return name, params, value, true
+}
+
+func hasType(types []string, wanted string) bool {
+	for _, t := range types {
+		if t == wanted {
+			return true
+		}
+	}
+	return false
+}
+
+// splitStructured splits a structured value on unescaped semicolons and unescapes the parts.
+// The result always has at least n parts.
+func splitStructured(value string, n int) []string {
+	parts := make([]string, 0, n)
+	var current strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			current.WriteByte(value[i])
+			current.WriteByte(value[i+1])
+			i++
+		} else if value[i] == ';' {
+			parts = append(parts, unescape(current.String()))
+			current.Reset()
+		} else {
+			current.WriteByte(value[i])
+		}
+	}
+	parts = append(parts, unescape(current.String()))
+	for len(parts) < n {
+		parts = append(parts, "")
+	}
+	return parts
+}
+
+func unescape(value string) string {
+	var buf strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+			if value[i] == 'n' || value[i] == 'N' {
+				buf.WriteByte('\n')
+			} else {
+				buf.WriteByte(value[i])
+			}
+		} else {
+			buf.WriteByte(value[i])
+		}
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func filterEmpty(parts []string) []string {
+	filtered := parts[:0]
+	for _, part := range parts {
+		if part != "" {
+			filtered = append(filtered, part)
+		}
+	}
+	return filtered
+}
+
+func nonEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return proto.String(value)
+}
@@ -0,0 +1,114 @@
+package signalvcard
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+)
+
+func TestFormatAndParse(t *testing.T) {
+	contact := &signalpb.DataMessage_Contact{
+		Name: &signalpb.DataMessage_Contact_Name{
+			GivenName:   proto.String("Alice"),
+			FamilyName:  proto.String("Smith; Jones"),
+			DisplayName: proto.String("Alice Smith, Jr."),
+		},
+		Number: []*signalpb.DataMessage_Contact_Phone{{
+			Value: proto.String("+15551234567"),
+			Type:  signalpb.DataMessage_Contact_Phone_MOBILE.Enum(),
+		}, {
+			Value: proto.String("+15557654321"),
+			Type:  signalpb.DataMessage_Contact_Phone_WORK.Enum(),
+		}},
+		Email: []*signalpb.DataMessage_Contact_Email{{
+			Value: proto.String("alice@example.com"),
+			Type:  signalpb.DataMessage_Contact_Email_HOME.Enum(),
+		}},
+		Address: []*signalpb.DataMessage_Contact_PostalAddress{{
+			Type:     signalpb.DataMessage_Contact_PostalAddress_WORK.Enum(),
+			Street:   proto.String("1 Main St\nFloor 2"),
+			City:     proto.String("Springfield"),
+			Postcode: proto.String("12345"),
+		}},
+		Organization: proto.String("Example Inc"),
+	}
+	avatar := bytes.Repeat([]byte{0xff, 0xd8, 0xff, 0xe0}, 100)
+
+	data := Format(contact, avatar, "image/jpeg")
+	for _, line := range strings.Split(string(data), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 octets: %q", line)
+		}
+	}
+	if !bytes.Contains(data, []byte("FN:Alice Smith\\, Jr.\r\n")) {
+		t.Errorf("FN not escaped properly:\n%s", data)
+	}
+
+	parsed, parsedAvatar, err := Parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse formatted vCard: %v", err)
+	}
+	if !proto.Equal(parsed, contact) {
+		t.Errorf("round trip mismatch:\nexpected %v\ngot      %v", contact, parsed)
+	}
+	if !bytes.Equal(parsedAvatar, avatar) {
+		t.Errorf("avatar mismatch: got %d bytes", len(parsedAvatar))
+	}
+}
+
+func TestParseOtherVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		vcard   string
+		display string
+		numbers []string
+		avatar  string
+	}{{
+		name:    "vCard 2.1",
+		vcard:   "BEGIN:VCARD\nVERSION:2.1\nN:Doe;John\nTEL;CELL:+1 555 000\nTEL;HOME;VOICE:+1 555 111\nPHOTO;ENCODING=BASE64;JPEG:aGVs\n bG8=\nEND:VCARD\n",
+		display: "John Doe",
+		numbers: []string{"+1 555 000", "+1 555 111"},
+		avatar:  "hello",
+	}, {
+		name:    "vCard 4.0 with groups",
+		vcard:   "BEGIN:VCARD\r\nVERSION:4.0\r\nFN:Bob\r\nitem1.TEL;VALUE=uri;TYPE=\"voice,work\":tel:+44 20 0000\r\nPHOTO:data:image/png;base64,aGk=\r\nEND:VCARD\r\n",
+		display: "Bob",
+		numbers: []string{"+44 20 0000"},
+		avatar:  "hi",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contact, avatar, err := Parse([]byte(test.vcard))
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if name := DisplayName(contact); name != test.display {
+				t.Errorf("expected name %q, got %q", test.display, name)
+			}
+			if len(contact.GetNumber()) != len(test.numbers) {
+				t.Fatalf("expected %d numbers, got %v", len(test.numbers), contact.GetNumber())
+			}
+			for i, number := range test.numbers {
+				if contact.GetNumber()[i].GetValue() != number {
+					t.Errorf("expected number %q, got %q", number, contact.GetNumber()[i].GetValue())
+				}
+			}
+			if string(avatar) != test.avatar {
+				t.Errorf("expected avatar %q, got %q", test.avatar, avatar)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, data := range []string{"", "hello world", "BEGIN:VCARD\nVERSION:3.0\nEND:VCARD\n"} {
+		if _, _, err := Parse([]byte(data)); !errors.Is(err, ErrNoVCard) {
+			t.Errorf("expected ErrNoVCard for %q, got %v", data, err)
+		}
+	}
+}
@@ -3,12 +3,20 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"image"
 	"image/color"
 	"image/png"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"os/exec"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -29,7 +37,10 @@ import (
 	"maunium.net/go/mautrix/id"
 
 	"go.mau.fi/mautrix-signal/database"
+	"go.mau.fi/mautrix-signal/pkg/signalfmt"
 	"go.mau.fi/mautrix-signal/pkg/signalmeow"
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+	"go.mau.fi/mautrix-signal/pkg/signalvcard"
 	"go.mau.fi/util/exerrors"
 	"go.mau.fi/util/ffmpeg"
 	"go.mau.fi/util/jsontime"
@@ -63,10 +74,16 @@ type Portal struct {
 	currentlyTypingLock sync.Mutex
 
 	latestReadTimestamp uint64 // Cache the latest read timestamp to avoid unnecessary read receipts
+	lastSyncedRevision  uint32 // The group revision the room membership was last synced with
+
+	disappearingSleepers sync.Map // Event IDs of disappearing messages that already have a redaction scheduled
 }
 
 const recentMessageBufferSize = 32
 
+// Signal clients only accept edits of messages from the last day
+const signalEditMaxAge = 24 * time.Hour
+
 func init() {
 	event.TypeMap[event.StateBridge] = reflect.TypeOf(CustomBridgeInfoContent{})
 	event.TypeMap[event.StateHalfShotBridge] = reflect.TypeOf(CustomBridgeInfoContent{})
@@ -78,10 +95,10 @@ var _ bridge.Portal = (*Portal)(nil)
 
 var _ bridge.ReadReceiptHandlingPortal = (*Portal)(nil)
 var _ bridge.TypingPortal = (*Portal)(nil)
+var _ bridge.MetaHandlingPortal = (*Portal)(nil)
 
 //var _ bridge.DisappearingPortal = (*Portal)(nil)
 //var _ bridge.MembershipHandlingPortal = (*Portal)(nil)
-//var _ bridge.MetaHandlingPortal = (*Portal)(nil)
 
 // ** bridge.Portal Interface **
 
@@ -143,7 +160,9 @@ func (portal *Portal) getBridgeInfo() (string, CustomBridgeInfoContent) {
 	}
 	var bridgeInfoStateKey string
 	bridgeInfoStateKey = fmt.Sprintf("fi.mau.signal://signal/%s", portal.Key().ChatID)
-	bridgeInfo.Channel.ExternalURL = fmt.Sprintf("https://signal.me/#p/%s", portal.Key().ChatID)
+	if !portal.IsStories() {
+		bridgeInfo.Channel.ExternalURL = fmt.Sprintf("https://signal.me/#p/%s", portal.Key().ChatID)
+	}
 	var roomType string
 	if portal.IsPrivateChat() {
 		roomType = "dm"
@@ -184,6 +203,10 @@ func (br *SignalBridge) getAllPortals() []*Portal {
 	return br.dbPortalsToPortals(br.DB.Portal.GetAll())
 }
 
+func (br *SignalBridge) getAllPortalsWithMXID() []*Portal {
+	return br.dbPortalsToPortals(br.DB.Portal.AllWithRoom())
+}
+
 func (br *SignalBridge) dbPortalsToPortals(dbPortals []*database.Portal) []*Portal {
 	br.portalsLock.Lock()
 	defer br.portalsLock.Unlock()
@@ -246,6 +269,12 @@ func (portal *Portal) handleMatrixMessages(msg portalMatrixMessage) {
 		portal.handleMatrixRedaction(msg.user, msg.evt)
 	case event.EventReaction:
 		portal.handleMatrixReaction(msg.user, msg.evt)
+	case event.StateMember:
+		portal.handleMatrixMembership(msg.user, msg.evt)
+	case event.StatePowerLevels:
+		portal.handleMatrixPowerLevels(msg.user, msg.evt)
+	case event.StateRoomName, event.StateTopic, event.StateRoomAvatar:
+		portal.handleMatrixRoomMetadata(msg.user, msg.evt)
 	default:
 		portal.log.Warn().Str("type", msg.evt.Type.String()).Msg("Unhandled matrix message type")
 	}
@@ -333,9 +362,13 @@ func (portal *Portal) handleMatrixMessage(sender *User, evt *event.Event) {
 
 	timings.totalSend = time.Since(start)
 	go ms.sendMessageMetrics(evt, err, "Error sending", true)
-	if err == nil {
-		//dbMsg.MarkSent(resp.Timestamp)
-		portal.storeMessageInDB(evt.ID, sender.SignalID, uint64(start.UnixMilli()))
+	// Edits are sent for the original message, so they don't get their own entry
+	if err == nil && msg.EditMessage == nil {
+		portal.storeMessageInDB(evt.ID, sender.SignalID, msg.DataMessage.GetTimestamp(), 0)
+		portal.MarkDisappearing(evt.ID, uint32(portal.ExpirationTime), true)
+	} else if err == nil {
+		originalTimestamp := portal.bridge.DB.MessageEdit.GetOriginalTimestamp(portal.ChatID, portal.Receiver, sender.SignalID, msg.EditMessage.GetTargetSentTimestamp())
+		portal.bridge.DB.MessageEdit.Insert(portal.ChatID, portal.Receiver, sender.SignalID, originalTimestamp, msg.EditMessage.GetDataMessage().GetTimestamp())
 	}
 }
 
@@ -365,6 +398,7 @@ func (portal *Portal) handleMatrixRedaction(sender *User, evt *event.Event) {
 			portal.log.Error().Msgf("Failed to send redaction %s", evt.ID)
 			return
 		}
+		portal.redactOtherParts(dbMessage)
 		dbMessage.Delete(nil)
 	}
 
@@ -383,7 +417,33 @@ func (portal *Portal) handleMatrixRedaction(sender *User, evt *event.Event) {
 	portal.sendMessageStatusCheckpointSuccess(evt)
 }
 
+// redactOtherParts redacts the rest of the Matrix events a Signal message was bridged as,
+// after one of them was redacted on Matrix and the message was deleted on Signal
+func (portal *Portal) redactOtherParts(dbMessage *database.Message) {
+	parts := portal.bridge.DB.Message.GetAllPartsBySignalID(dbMessage.Sender, dbMessage.Timestamp, portal.ChatID, portal.Receiver)
+	if len(parts) <= 1 {
+		return
+	}
+	intent := portal.MainIntent()
+	if puppet := portal.bridge.GetPuppetBySignalID(dbMessage.Sender); puppet != nil {
+		intent = puppet.IntentFor(portal)
+	}
+	for _, part := range parts {
+		if part.MXID == dbMessage.MXID {
+			continue
+		}
+		_, err := intent.RedactEvent(portal.MXID, part.MXID)
+		if err != nil {
+			portal.log.Warn().Err(err).Msgf("Failed to redact part %d of message %s", part.PartIndex, dbMessage.MXID)
+		}
+	}
+}
+
 func (portal *Portal) handleMatrixReaction(sender *User, evt *event.Event) {
+	if portal.IsStories() {
+		portal.sendMessageStatusCheckpointFailed(evt, errBroadcastReactionNotSupported)
+		return
+	}
 	// Find the original signal message based on eventID
 	relatedEventID := evt.Content.AsReaction().RelatesTo.EventID
 	dbMessage := portal.bridge.DB.Message.GetByMXID(relatedEventID)
@@ -426,12 +486,15 @@ func (portal *Portal) handleMatrixReaction(sender *User, evt *event.Event) {
 	}
 
 	// Store our new reaction in the database
-	portal.storeReactionInDB(evt.ID, sender.SignalID, targetAuthorUUID, targetTimestamp, signalEmoji)
+	portal.storeReactionInDB(evt.ID, sender.SignalID, targetAuthorUUID, targetTimestamp, dbMessage.PartIndex, signalEmoji)
 
 	portal.sendMessageStatusCheckpointSuccess(evt)
 }
 
 func (portal *Portal) downloadAndDecryptMatrixMedia(ctx context.Context, content *event.MessageEventContent) ([]byte, error) {
+	if maxSize := portal.bridge.Config.Bridge.MaxAttachmentSize(); int64(content.GetInfo().Size) > maxSize {
+		return nil, fmt.Errorf("%w (%d > %d bytes)", errMediaTooLarge, content.GetInfo().Size, maxSize)
+	}
 	var file *event.EncryptedFileInfo
 	rawMXC := content.URL
 	if content.File != nil {
@@ -455,6 +518,66 @@ func (portal *Portal) downloadAndDecryptMatrixMedia(ctx context.Context, content
 	return data, nil
 }
 
+// downloadMatrixMediaToFile streams Matrix media into a temp file, decrypting it on the way if needed.
+// The caller must get rid of the file with removeTempFile.
+func (portal *Portal) downloadMatrixMediaToFile(ctx context.Context, content *event.MessageEventContent) (*os.File, error) {
+	maxSize := portal.bridge.Config.Bridge.MaxAttachmentSize()
+	if int64(content.GetInfo().Size) > maxSize {
+		return nil, fmt.Errorf("%w (%d > %d bytes)", errMediaTooLarge, content.GetInfo().Size, maxSize)
+	}
+	var encryptedFile *event.EncryptedFileInfo
+	rawMXC := content.URL
+	if content.File != nil {
+		encryptedFile = content.File
+		rawMXC = encryptedFile.URL
+	}
+	mxc, err := rawMXC.Parse()
+	if err != nil {
+		return nil, err
+	}
+	if encryptedFile != nil {
+		if err = encryptedFile.PrepareForDecryption(); err != nil {
+			return nil, exerrors.NewDualError(errMediaDecryptFailed, err)
+		}
+	}
+	reader, err := portal.MainIntent().DownloadContext(ctx, mxc)
+	if err != nil {
+		return nil, exerrors.NewDualError(errMediaDownloadFailed, err)
+	}
+	defer reader.Close()
+	var body io.Reader = reader
+	var decryptStream io.ReadCloser
+	if encryptedFile != nil {
+		decryptStream = encryptedFile.DecryptStream(reader)
+		body = decryptStream
+	}
+
+	file, err := os.CreateTemp("", "mautrix-signal-media-*")
+	if err != nil {
+		return nil, err
+	}
+	size, err := io.Copy(file, io.LimitReader(body, maxSize+1))
+	if err != nil {
+		removeTempFile(file)
+		return nil, exerrors.NewDualError(errMediaDownloadFailed, err)
+	} else if size > maxSize {
+		removeTempFile(file)
+		return nil, fmt.Errorf("%w (more than %d bytes)", errMediaTooLarge, maxSize)
+	}
+	if decryptStream != nil {
+		// Closing checks the hash of the encrypted file
+		if err = decryptStream.Close(); err != nil {
+			removeTempFile(file)
+			return nil, exerrors.NewDualError(errMediaDecryptFailed, err)
+		}
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		removeTempFile(file)
+		return nil, err
+	}
+	return file, nil
+}
+
 func (portal *Portal) convertWebPtoPNG(webpImage []byte) ([]byte, error) {
 	webpDecoded, err := webp.Decode(bytes.NewReader(webpImage))
 	if err != nil {
@@ -551,9 +674,11 @@ func (portal *Portal) convertSticker(ctx context.Context, mimeType string, stick
 	return outMimeType, outSticker, nil
 }
 
-func (portal *Portal) convertVideo(ctx context.Context, mimeType string, video []byte) (string, []byte, error) {
+// convertVideo converts videos to a format Signal clients can play. If the video is converted, the returned file is a
+// new temp file that must be removed as well.
+func (portal *Portal) convertVideo(ctx context.Context, mimeType string, video *os.File) (string, *os.File, error) {
 	var outMimeType string
-	var outVideo []byte
+	var outVideo *os.File
 	var err error
 	switch mimeType {
 	case "video/mp4", "video/3gpp":
@@ -562,9 +687,13 @@ func (portal *Portal) convertVideo(ctx context.Context, mimeType string, video [
 		outVideo = video
 	case "video/webm":
 		outMimeType = "video/mp4"
-		outVideo, err = ffmpeg.ConvertBytes(ctx, video, ".mp4", []string{"-f", "webm"}, []string{
+		var outPath string
+		outPath, err = ffmpeg.ConvertPath(ctx, video.Name(), ".mp4", []string{"-f", "webm"}, []string{
 			"-pix_fmt", "yuv420p", "-c:v", "libx264",
-		}, mimeType)
+		}, false)
+		if err == nil {
+			outVideo, err = os.Open(outPath)
+		}
 	default:
 		return "", nil, fmt.Errorf("%w %q in video message", errMediaUnsupportedType, mimeType)
 	}
@@ -574,9 +703,9 @@ func (portal *Portal) convertVideo(ctx context.Context, mimeType string, video [
 	return outMimeType, outVideo, nil
 }
 
-func (portal *Portal) convertAudio(ctx context.Context, mimeType string, audio []byte) (string, []byte, error) {
+func (portal *Portal) convertAudio(ctx context.Context, mimeType string, audio *os.File) (string, *os.File, error) {
 	var outMimeType string
-	var outAudio []byte
+	var outAudio *os.File
 	var err error
 	switch mimeType {
 	case "audio/aac", "audio/mp4", "audio/amr", "audio/mpeg", "audio/ogg; codecs=opus":
@@ -600,20 +729,42 @@ func (portal *Portal) convertMatrixMessage(ctx context.Context, sender *User, ev
 	content, ok := evt.Content.Parsed.(*event.MessageEventContent)
 	if !ok {
 		return nil, fmt.Errorf("%w %T", errUnexpectedParsedContentType, evt.Content.Parsed)
+	} else if portal.IsStories() {
+		return nil, errBroadcastSendDisabled
 	}
 
 	if evt.Type == event.EventSticker {
 		content.MsgType = event.MessageType(event.EventSticker.Type)
 	}
 
+	// Edits replace the text of a message we sent earlier
+	var editTarget *database.Message
+	if replaceID := content.RelatesTo.GetReplaceID(); replaceID != "" {
+		editTarget = portal.bridge.DB.Message.GetByMXID(replaceID)
+		if editTarget == nil {
+			return nil, fmt.Errorf("%w %s", errEditUnknownTarget, replaceID)
+		} else if editTarget.Sender != sender.SignalID {
+			return nil, errEditDifferentSender
+		} else if time.Since(time.UnixMilli(int64(editTarget.Timestamp))) > signalEditMaxAge {
+			return nil, errEditTooOld
+		}
+		if content.NewContent != nil {
+			content = content.NewContent
+		}
+		if content.MsgType != event.MsgText && content.MsgType != event.MsgEmote && content.MsgType != event.MsgNotice {
+			return nil, fmt.Errorf("%w %q", errEditUnknownTargetType, content.MsgType)
+		}
+	}
+
 	var outgoingMessage *signalmeow.SignalContent
 
 	switch content.MsgType {
 	case event.MsgText, event.MsgEmote, event.MsgNotice:
 		var text string
 		var mentions []string
+		var styles []signalmeow.StyleRange
 		if content.Format == event.FormatHTML {
-			text, mentions = portal.parseMentionsFromMatrixBody(content)
+			text, mentions, styles = portal.parseMatrixFormattedBody(content)
 		} else {
 			text = content.Body
 			mentions = nil
@@ -624,14 +775,21 @@ func (portal *Portal) convertMatrixMessage(ctx context.Context, sender *User, ev
 		if content.MsgType == event.MsgEmote {
 			text = "/me " + text
 		}
-		//hasPreview := portal.convertURLPreviewToWhatsApp(ctx, sender, evt, msg.ExtendedTextMessage)
+		outgoingMessage = signalmeow.DataMessageForText(text, uint32(portal.ExpirationTime))
+		portal.convertURLPreviewToSignal(ctx, sender, evt, text, outgoingMessage)
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
-		outgoingMessage = signalmeow.DataMessageForText(text)
 		if mentions != nil && len(mentions) > 0 {
 			signalmeow.AddMentionsToDataMessage(outgoingMessage, mentions)
 		}
+		if content.MsgType == event.MsgEmote {
+			// The styles don't include the "/me " prefix
+			for i := range styles {
+				styles[i].Start += uint32(len("/me "))
+			}
+		}
+		signalmeow.AddStylesToDataMessage(outgoingMessage, styles)
 
 	case event.MsgImage:
 		fileName := content.Body
@@ -652,7 +810,7 @@ func (portal *Portal) convertMatrixMessage(ctx context.Context, sender *User, ev
 		if err != nil {
 			return nil, err
 		}
-		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption)
+		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption, uint32(portal.ExpirationTime))
 
 	case event.MessageType(event.EventSticker.Type):
 		fileName := content.Body
@@ -673,7 +831,7 @@ func (portal *Portal) convertMatrixMessage(ctx context.Context, sender *User, ev
 		if err != nil {
 			return nil, err
 		}
-		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption)
+		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption, uint32(portal.ExpirationTime))
 	case event.MsgVideo:
 		fileName := content.Body
 		var caption string
@@ -681,19 +839,31 @@ func (portal *Portal) convertMatrixMessage(ctx context.Context, sender *User, ev
 			fileName = content.FileName
 			caption = content.Body
 		}
-		image, err := portal.downloadAndDecryptMatrixMedia(ctx, content)
+		if isVCard(fileName, content.GetInfo().MimeType) {
+			contactMessage, err := portal.convertMatrixVCard(ctx, sender, content)
+			if err == nil {
+				outgoingMessage = contactMessage
+				break
+			}
+			portal.log.Warn().Err(err).Msg("Failed to convert vCard to Signal contact, sending as a file")
+		}
+		file, err := portal.downloadMatrixMediaToFile(ctx, content)
 		if err != nil {
 			return nil, err
 		}
-		newMimeType, convertedVideo, err := portal.convertVideo(ctx, content.GetInfo().MimeType, image)
+		defer removeTempFile(file)
+		newMimeType, convertedVideo, err := portal.convertVideo(ctx, content.GetInfo().MimeType, file)
 		if err != nil {
 			return nil, err
 		}
-		attachmentPointer, err := signalmeow.UploadAttachment(sender.SignalDevice, convertedVideo, newMimeType, fileName)
+		if convertedVideo != file {
+			defer removeTempFile(convertedVideo)
+		}
+		attachmentPointer, err := signalmeow.UploadAttachmentReader(sender.SignalDevice, convertedVideo, newMimeType, fileName, portal.bridge.Config.Bridge.MaxAttachmentSize())
 		if err != nil {
 			return nil, err
 		}
-		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption)
+		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption, uint32(portal.ExpirationTime))
 
 	case event.MsgAudio:
 		fileName := content.Body
@@ -702,19 +872,20 @@ func (portal *Portal) convertMatrixMessage(ctx context.Context, sender *User, ev
 			fileName = content.FileName
 			caption = content.Body
 		}
-		image, err := portal.downloadAndDecryptMatrixMedia(ctx, content)
+		file, err := portal.downloadMatrixMediaToFile(ctx, content)
 		if err != nil {
 			return nil, err
 		}
-		newMimeType, convertedAudio, err := portal.convertAudio(ctx, content.GetInfo().MimeType, image)
+		defer removeTempFile(file)
+		newMimeType, convertedAudio, err := portal.convertAudio(ctx, content.GetInfo().MimeType, file)
 		if err != nil {
 			return nil, err
 		}
-		attachmentPointer, err := signalmeow.UploadAttachment(sender.SignalDevice, convertedAudio, newMimeType, fileName)
+		attachmentPointer, err := signalmeow.UploadAttachmentReader(sender.SignalDevice, convertedAudio, newMimeType, fileName, portal.bridge.Config.Bridge.MaxAttachmentSize())
 		if err != nil {
 			return nil, err
 		}
-		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption)
+		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption, uint32(portal.ExpirationTime))
 
 	case event.MsgFile:
 		fileName := content.Body
@@ -723,18 +894,24 @@ func (portal *Portal) convertMatrixMessage(ctx context.Context, sender *User, ev
 			fileName = content.FileName
 			caption = content.Body
 		}
-		file, err := portal.downloadAndDecryptMatrixMedia(ctx, content)
+		file, err := portal.downloadMatrixMediaToFile(ctx, content)
 		if err != nil {
 			return nil, err
 		}
-		attachmentPointer, err := signalmeow.UploadAttachment(sender.SignalDevice, file, content.GetInfo().MimeType, fileName)
+		defer removeTempFile(file)
+		attachmentPointer, err := signalmeow.UploadAttachmentReader(sender.SignalDevice, file, content.GetInfo().MimeType, fileName, portal.bridge.Config.Bridge.MaxAttachmentSize())
 		if err != nil {
 			return nil, err
 		}
-		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption)
+		outgoingMessage = signalmeow.DataMessageForAttachment(attachmentPointer, caption, uint32(portal.ExpirationTime))
 
 	case event.MsgLocation:
-		fallthrough
+		locationMessage, err := portal.convertMatrixLocation(ctx, sender, evt, content)
+		if err != nil {
+			return nil, err
+		}
+		outgoingMessage = locationMessage
+
 	default:
 		return nil, fmt.Errorf("%w %q", errUnknownMsgType, content.MsgType)
 	}
@@ -752,10 +929,18 @@ func (portal *Portal) convertMatrixMessage(ctx context.Context, sender *User, ev
 			originalMessage.Timestamp,
 		)
 	}
+	if editTarget != nil {
+		// Edits of an edited message target the previous edit
+		targetTimestamp := portal.bridge.DB.MessageEdit.GetLatestTimestamp(editTarget.SignalChatID, editTarget.SignalReceiver, editTarget.Sender, editTarget.Timestamp)
+		outgoingMessage = signalmeow.EditMessageForContent(outgoingMessage, targetTimestamp)
+	}
 	return outgoingMessage, nil
 }
 
 func (portal *Portal) sendSignalMessage(ctx context.Context, msg *signalmeow.SignalContent, sender *User, evtID id.EventID) error {
+	if portal.IsStories() {
+		return errBroadcastSendDisabled
+	}
 	recipientSignalID := portal.ChatID
 	portal.log.Debug().Msgf("Sending event %s to Signal %s", evtID, recipientSignalID)
 
@@ -833,7 +1018,13 @@ func (portal *Portal) handleSignalMessages(portalMessage portalSignalMessage) {
 	}
 
 	var err error
-	if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeText {
+	if portalMessage.message.Base().ViewOnce && portal.bridge.Config.Bridge.ViewOnce.Mode == "notice" {
+		err = portal.handleSignalViewOnceNotice(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle view-once message")
+			return
+		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeText {
 		err = portal.handleSignalTextMessage(portalMessage, intent)
 		if err != nil {
 			portal.log.Error().Err(err).Msg("Failed to handle text message")
@@ -845,12 +1036,27 @@ func (portal *Portal) handleSignalMessages(portalMessage portalSignalMessage) {
 			portal.log.Error().Err(err).Msg("Failed to handle image message")
 			return
 		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeVideo ||
+		portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeAudio ||
+		portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeVoiceNote ||
+		portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeFile {
+		err = portal.handleSignalAttachmentMessage(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle attachment message")
+			return
+		}
 	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeReaction {
 		_, err := portal.handleSignalReactionMessage(portalMessage, intent)
 		if err != nil {
 			portal.log.Error().Err(err).Msg("Failed to handle reaction message")
 			return
 		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeEdit {
+		err := portal.handleSignalEditMessage(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle edit message")
+			return
+		}
 	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeDelete {
 		err := portal.handleSignalDeleteMessage(portalMessage, intent)
 		if err != nil {
@@ -863,6 +1069,18 @@ func (portal *Portal) handleSignalMessages(portalMessage portalSignalMessage) {
 			portal.log.Error().Err(err).Msg("Failed to handle sticker message")
 			return
 		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeContact {
+		err := portal.handleSignalContactMessage(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle contact message")
+			return
+		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeStory {
+		err := portal.handleSignalStoryMessage(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle story message")
+			return
+		}
 	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeTyping {
 		err := portal.handleSignalTypingMessage(portalMessage, intent)
 		if err != nil {
@@ -882,20 +1100,44 @@ func (portal *Portal) handleSignalMessages(portalMessage portalSignalMessage) {
 			portal.log.Error().Err(err).Msg("Failed to handle call message")
 			return
 		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeCallEvent {
+		err := portal.handleSignalCallEventMessage(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle call event message")
+			return
+		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeViewOnceOpen {
+		err := portal.handleSignalViewOnceOpenMessage(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle view-once open message")
+			return
+		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeGroupChange {
+		err := portal.handleSignalGroupChangeMessage(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle group change message")
+			return
+		}
+	} else if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeExpireTimer {
+		err := portal.handleSignalExpireTimerMessage(portalMessage, intent)
+		if err != nil {
+			portal.log.Error().Err(err).Msg("Failed to handle expire timer message")
+			return
+		}
 	} else {
 		portal.log.Warn().Msgf("Unknown message type: %v", portalMessage.message.MessageType())
 		return
 	}
 	// TODO: send receipt
-	// TODO: expire if it's an expiring message
 }
 
-func (portal *Portal) storeMessageInDB(eventID id.EventID, senderSignalID string, timestamp uint64) {
+func (portal *Portal) storeMessageInDB(eventID id.EventID, senderSignalID string, timestamp uint64, partIndex int) {
 	dbMessage := portal.bridge.DB.Message.New()
 	dbMessage.MXID = eventID
 	dbMessage.MXRoom = portal.MXID
 	dbMessage.Sender = senderSignalID
 	dbMessage.Timestamp = timestamp
+	dbMessage.PartIndex = partIndex
 	dbMessage.SignalChatID = portal.ChatID
 	dbMessage.SignalReceiver = portal.Receiver
 	dbMessage.Insert(nil)
@@ -906,6 +1148,7 @@ func (portal *Portal) storeReactionInDB(
 	senderSignalID string,
 	msgAuthor string,
 	msgTimestamp uint64,
+	msgPartIndex int,
 	emoji string,
 ) {
 	dbReaction := portal.bridge.DB.Reaction.New()
@@ -916,6 +1159,7 @@ func (portal *Portal) storeReactionInDB(
 	dbReaction.Author = senderSignalID
 	dbReaction.MsgAuthor = msgAuthor
 	dbReaction.MsgTimestamp = msgTimestamp
+	dbReaction.MsgPartIndex = msgPartIndex
 	dbReaction.Emoji = emoji
 	dbReaction.Insert(nil)
 }
@@ -940,12 +1184,24 @@ func (portal *Portal) addSignalQuote(content *event.MessageEventContent, quote *
 	}
 }
 
-func (portal *Portal) addMentionsToMatrixBody(content *event.MessageEventContent, mentions []signalmeow.IncomingSignalMessageMentionData) {
+// addFormattingToMatrixBody turns the mentions and styles of a Signal message into HTML and Matrix mentions.
+// The body of the content must be the text of the Signal message, which has a placeholder for each mention.
+func (portal *Portal) addFormattingToMatrixBody(content *event.MessageEventContent, mentions []signalmeow.IncomingSignalMessageMentionData, styles []signalmeow.StyleRange) {
+	if len(mentions) == 0 && len(styles) == 0 {
+		return
+	}
+	ranges := make([]signalfmt.BodyRange, 0, len(mentions)+len(styles))
 	matrixMentions := event.Mentions{
 		UserIDs: []id.UserID{},
 	}
 	for _, mention := range mentions {
+		if mention.MentionedUUID == "" {
+			continue
+		}
 		puppet := portal.bridge.GetPuppetBySignalID(mention.MentionedUUID)
+		if puppet == nil {
+			continue
+		}
 		mxID := puppet.MXID
 
 		// If the mention is us, make sure we use our Matrix display name to make the client actually pings us
@@ -959,15 +1215,26 @@ func (portal *Portal) addMentionsToMatrixBody(content *event.MessageEventContent
 		if err != nil {
 			portal.log.Warn().Err(err).Msgf("Failed to get display name for %s", mxID)
 		}
-		if matrixName.DisplayName != "" {
+		if matrixName != nil && matrixName.DisplayName != "" {
 			mentionName = matrixName.DisplayName
 		}
-		htmlMentionName := fmt.Sprintf("<a href=\"https://matrix.to/#/%s\">%s</a>", mxID, mentionName)
-
-		content.Body = strings.Replace(content.Body, "\uFFFC", mentionName, 1)
-		content.FormattedBody = strings.Replace(content.FormattedBody, "\uFFFC", htmlMentionName, 1)
+		ranges = append(ranges, signalfmt.BodyRange{
+			Start:       int(mention.Start),
+			Length:      int(mention.Length),
+			MentionMXID: mxID,
+			MentionName: mentionName,
+		})
 		matrixMentions.UserIDs = append(matrixMentions.UserIDs, mxID)
 	}
+	for _, style := range styles {
+		ranges = append(ranges, signalfmt.BodyRange{
+			Start:  int(style.Start),
+			Length: int(style.Length),
+			Style:  signalfmt.Style(style.Style),
+		})
+	}
+	content.Body, content.FormattedBody = signalfmt.ToMatrix(content.Body, ranges)
+	content.Format = event.FormatHTML
 	if len(matrixMentions.UserIDs) > 0 {
 		content.Mentions = &matrixMentions
 	}
@@ -975,7 +1242,9 @@ func (portal *Portal) addMentionsToMatrixBody(content *event.MessageEventContent
 
 const mentionedSignalIDsContextKey = "fi.mau.signal.mentioned_ids"
 
-func (portal *Portal) parseMentionsFromMatrixBody(content *event.MessageEventContent) (string, []string) {
+// parseMatrixFormattedBody converts the HTML of a Matrix message into Signal text, with a placeholder
+// for each mention, and returns the text with the Signal IDs of the mentioned users and the styles of the text.
+func (portal *Portal) parseMatrixFormattedBody(content *event.MessageEventContent) (string, []string, []signalmeow.StyleRange) {
 	var allowedMentions map[string]bool = nil
 	var mentionedSignalIDs []string
 
@@ -1022,43 +1291,56 @@ func (portal *Portal) parseMentionsFromMatrixBody(content *event.MessageEventCon
 			}
 			return displayname
 		},
-		BoldConverter:           func(text string, _ format.Context) string { return fmt.Sprintf("*%s*", text) },
-		ItalicConverter:         func(text string, _ format.Context) string { return fmt.Sprintf("_%s_", text) },
-		StrikethroughConverter:  func(text string, _ format.Context) string { return fmt.Sprintf("~%s~", text) },
-		MonospaceConverter:      func(text string, _ format.Context) string { return fmt.Sprintf("```%s```", text) },
-		MonospaceBlockConverter: func(text, language string, _ format.Context) string { return fmt.Sprintf("```%s```", text) },
 	}
+	signalfmt.AddStyleConverters(matrixHTMLParser)
 
 	formatContext := format.NewContext()
-	parsedBody := matrixHTMLParser.Parse(content.FormattedBody, formatContext)
+	parsedBody, bodyRanges := signalfmt.ExtractStyles(matrixHTMLParser.Parse(content.FormattedBody, formatContext))
+	styles := make([]signalmeow.StyleRange, 0, len(bodyRanges))
+	for _, bodyRange := range bodyRanges {
+		styles = append(styles, signalmeow.StyleRange{
+			Start:  uint32(bodyRange.Start),
+			Length: uint32(bodyRange.Length),
+			Style:  signalpb.BodyRange_Style(bodyRange.Style),
+		})
+	}
 
 	// If we didn't have any explicit mentions, we can use the ones we parsed from the HTML
 	if content.Mentions == nil {
 		mentionedSignalIDs, _ = formatContext.ReturnData[mentionedSignalIDsContextKey].([]string)
 	}
 
-	return parsedBody, mentionedSignalIDs
+	return parsedBody, mentionedSignalIDs, styles
 }
 
 func (portal *Portal) handleSignalTextMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
 	timestamp := portalMessage.message.Base().Timestamp
 	msg := (portalMessage.message).(signalmeow.IncomingSignalMessageText)
-	content := &event.MessageEventContent{
-		MsgType:       event.MsgText,
-		Body:          msg.Content,
-		Format:        event.FormatHTML,
-		FormattedBody: msg.Content,
+	content, isLocation := portal.convertSignalLocation(intent, msg)
+	if !isLocation {
+		content = &event.MessageEventContent{
+			MsgType: event.MsgText,
+			Body:    msg.Content,
+		}
+		portal.addFormattingToMatrixBody(content, msg.Mentions, msg.Styles)
 	}
 	portal.addSignalQuote(content, msg.Quote)
-	portal.addMentionsToMatrixBody(content, msg.Mentions)
-	resp, err := portal.sendMatrixMessage(intent, event.EventMessage, content, nil, 0)
+	portal.addSignalStoryQuote(content, portalMessage.user, msg.StoryContext)
+	var extraContent map[string]interface{}
+	if len(msg.Previews) > 0 && !isLocation {
+		extraContent = map[string]interface{}{
+			beeperLinkPreviewsKey: portal.convertSignalPreviewsToBeeper(intent, msg.Previews),
+		}
+	}
+	resp, err := portal.sendMatrixMessage(intent, event.EventMessage, content, extraContent, 0)
 	if err != nil {
 		return err
 	}
 	if resp.EventID == "" {
 		return errors.New("Didn't receive event ID from Matrix")
 	}
-	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, timestamp)
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, timestamp, portalMessage.message.Base().PartIndex)
+	portal.markSignalMessageDisappearing(portalMessage, resp.EventID)
 	return err
 }
 
@@ -1077,7 +1359,7 @@ func (portal *Portal) handleSignalStickerMessage(portalMessage portalSignalMessa
 	}
 
 	portal.addSignalQuote(content, msg.Quote)
-	portal.addMentionsToMatrixBody(content, msg.Mentions)
+	portal.addFormattingToMatrixBody(content, msg.Mentions, msg.Styles)
 	err := portal.uploadMediaToMatrix(intent, msg.Sticker, content)
 	if err != nil {
 		portal.log.Error().Err(err).Msg("Failed to upload media")
@@ -1090,7 +1372,308 @@ func (portal *Portal) handleSignalStickerMessage(portalMessage portalSignalMessa
 	if resp.EventID == "" {
 		return errors.New("Didn't receive event ID from Matrix")
 	}
-	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, timestamp)
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, timestamp, portalMessage.message.Base().PartIndex)
+	portal.markSignalMessageDisappearing(portalMessage, resp.EventID)
+	return err
+}
+
+func isVCard(fileName, mimeType string) bool {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	return mimeType == signalvcard.MimeType || mimeType == "text/x-vcard" || strings.HasSuffix(strings.ToLower(fileName), ".vcf")
+}
+
+// convertMatrixVCard turns a vCard file into a native Signal contact share
+func (portal *Portal) convertMatrixVCard(ctx context.Context, sender *User, content *event.MessageEventContent) (*signalmeow.SignalContent, error) {
+	data, err := portal.downloadAndDecryptMatrixMedia(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+	contact, avatar, err := signalvcard.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(avatar) > 0 {
+		avatarPointer, err := signalmeow.UploadAttachment(sender.SignalDevice, avatar, http.DetectContentType(avatar), "")
+		if err != nil {
+			portal.log.Err(err).Msg("Failed to upload contact avatar, sending contact without it")
+		} else {
+			contact.Avatar = &signalpb.DataMessage_Contact_Avatar{
+				Avatar: (*signalpb.AttachmentPointer)(avatarPointer),
+			}
+		}
+	}
+	return signalmeow.DataMessageForContact(contact, uint32(portal.ExpirationTime)), nil
+}
+
+// handleSignalContactMessage bridges a shared contact as a vCard file, with the details as the caption
+// for clients that can't open it.
+func (portal *Portal) handleSignalContactMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	timestamp := portalMessage.message.Base().Timestamp
+	msg := (portalMessage.message).(signalmeow.IncomingSignalMessageContact)
+
+	var avatar []byte
+	var avatarMimeType string
+	if msg.Avatar != nil {
+		var buf bytes.Buffer
+		err := signalmeow.DownloadAttachment(msg.Avatar, &buf, portal.bridge.Config.Bridge.MaxAttachmentSize())
+		if err != nil {
+			portal.log.Err(err).Msg("Failed to download contact avatar, sending contact without it")
+		} else {
+			avatar = buf.Bytes()
+			avatarMimeType = http.DetectContentType(avatar)
+		}
+	}
+	vcard := signalvcard.Format(msg.Contact, avatar, avatarMimeType)
+
+	fileName := strings.NewReplacer("/", "_", "\\", "_").Replace(signalvcard.DisplayName(msg.Contact)) + ".vcf"
+	content := &event.MessageEventContent{
+		MsgType:  event.MsgFile,
+		Body:     signalvcard.TextFallback(msg.Contact),
+		FileName: fileName,
+		Info: &event.FileInfo{
+			MimeType: signalvcard.MimeType,
+		},
+	}
+	portal.addSignalQuote(content, msg.Quote)
+	err := portal.uploadMediaToMatrix(intent, vcard, content)
+	if err != nil {
+		return err
+	}
+
+	resp, err := portal.sendMatrixMessage(intent, event.EventMessage, content, nil, 0)
+	if err != nil {
+		return err
+	}
+	if resp.EventID == "" {
+		return errors.New("Didn't receive event ID from Matrix")
+	}
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, timestamp, portalMessage.message.Base().PartIndex)
+	portal.markSignalMessageDisappearing(portalMessage, resp.EventID)
+	return err
+}
+
+// markSignalMessageDisappearing schedules the redaction of a bridged message if it has a disappearing timer.
+// Messages we sent from another device start their timer right away, others when they're read.
+// View-once media in the redact mode is redacted as soon as it's read instead.
+func (portal *Portal) markSignalMessageDisappearing(portalMessage portalSignalMessage, eventID id.EventID) {
+	base := portalMessage.message.Base()
+	if base.ViewOnce && portal.bridge.Config.Bridge.ViewOnce.Mode == "redact" {
+		portal.markSignalMessageViewOnce(portalMessage, eventID)
+		return
+	}
+	fromMe := base.SenderUUID == portalMessage.user.SignalID
+	portal.MarkDisappearing(eventID, base.ExpiresIn, fromMe)
+}
+
+func (portal *Portal) handleSignalExpireTimerMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	timerMessage := (portalMessage.message).(signalmeow.IncomingSignalMessageExpireTimer)
+	return portal.updateExpirationTime(intent, timerMessage.NewExpireTimer)
+}
+
+// updateGroupMetadata copies the title, description and avatar of a Signal group into the portal,
+// and returns whether anything changed. The room state has to be updated separately.
+// avatarImage is only set if there's a new avatar.
+func (portal *Portal) updateGroupMetadata(group *signalmeow.Group, avatarImage []byte) (bool, error) {
+	changed := false
+	if portal.Name != group.Title || portal.Topic != group.Description {
+		portal.Name = group.Title
+		portal.Topic = group.Description
+		changed = true
+	}
+	if avatarImage != nil {
+		portal.log.Debug().Msg("Uploading new group avatar")
+		avatarURL, err := portal.MainIntent().UploadBytes(avatarImage, http.DetectContentType(avatarImage))
+		if err != nil {
+			return changed, fmt.Errorf("failed to upload group avatar: %w", err)
+		}
+		portal.AvatarURL = avatarURL.ContentURI
+		portal.AvatarSet = true
+		hash := sha256.Sum256(avatarImage)
+		portal.AvatarHash = hex.EncodeToString(hash[:])
+		changed = true
+	}
+	return changed, nil
+}
+
+// updateExpirationTime saves a new disappearing message timer and tells the room about it
+func (portal *Portal) updateExpirationTime(intent *appservice.IntentAPI, expireTimer uint32) error {
+	if portal.ExpirationTime == int(expireTimer) {
+		return nil
+	}
+	portal.ExpirationTime = int(expireTimer)
+	err := portal.Update()
+	if err != nil {
+		return fmt.Errorf("failed to save expiration time: %w", err)
+	}
+	var message string
+	if expireTimer == 0 {
+		message = "Disappearing messages disabled"
+	} else {
+		message = fmt.Sprintf("Disappearing messages set to %s", formatDisappearingTimer(expireTimer))
+	}
+	content := &event.MessageEventContent{
+		MsgType: event.MsgNotice,
+		Body:    message,
+	}
+	_, err = portal.sendMatrixMessage(intent, event.EventMessage, content, nil, 0)
+	return err
+}
+
+func (portal *Portal) handleSignalGroupChangeMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	change := (portalMessage.message).(signalmeow.IncomingSignalMessageGroupChange).GroupChange
+	// Attribute the change to whoever made it, which isn't necessarily who sent the message about it
+	if change.Editor != "" && change.Editor != portalMessage.sender.SignalID {
+		if editor := portal.bridge.GetPuppetBySignalID(change.Editor); editor != nil {
+			intent = editor.IntentFor(portal)
+		}
+	}
+	portal.log.Debug().Msgf("Handling change to group %s (revision %d) by %s", change.GroupIdentifier, change.Revision, change.Editor)
+
+	updatePortal := false
+	if change.ModifyTitle != nil && portal.Name != *change.ModifyTitle {
+		portal.Name = *change.ModifyTitle
+		_, err := intent.SetRoomName(portal.MXID, portal.Name)
+		if err != nil {
+			portal.log.Err(err).Msg("error setting room name")
+		}
+		portal.NameSet = err == nil
+		updatePortal = true
+	}
+	if change.ModifyDescription != nil && portal.Topic != *change.ModifyDescription {
+		portal.Topic = *change.ModifyDescription
+		_, err := intent.SetRoomTopic(portal.MXID, portal.Topic)
+		if err != nil {
+			portal.log.Err(err).Msg("error setting room topic")
+		}
+		updatePortal = true
+	}
+	if change.ModifyAvatar != nil {
+		err := portal.updateGroupAvatar(portalMessage.user, intent, *change.ModifyAvatar)
+		if err != nil {
+			portal.log.Err(err).Msg("error updating group avatar")
+		} else {
+			updatePortal = true
+		}
+	}
+	if updatePortal {
+		err := portal.Update()
+		if err != nil {
+			portal.log.Err(err).Msg("error updating portal")
+		}
+		portal.UpdateBridgeInfo()
+	}
+
+	if change.ModifyDisappearingMessagesTimer != nil {
+		err := portal.updateExpirationTime(intent, *change.ModifyDisappearingMessagesTimer)
+		if err != nil {
+			portal.log.Err(err).Msg("error updating disappearing message timer")
+		}
+	}
+
+	var joinedMembers []*signalmeow.GroupMember
+	joinedMembers = append(joinedMembers, change.AddMembers...)
+	joinedMembers = append(joinedMembers, change.PromotePendingMembers...)
+	joinedMembers = append(joinedMembers, change.PromoteRequestingMembers...)
+	for _, member := range joinedMembers {
+		if member.UserId == portalMessage.user.SignalID {
+			continue
+		}
+		memberPuppet := portal.bridge.GetPuppetBySignalID(member.UserId)
+		if memberPuppet == nil {
+			continue
+		}
+		_ = updatePuppetWithSignalProfile(context.Background(), portalMessage.user, memberPuppet)
+		err := memberPuppet.DefaultIntent().EnsureJoined(portal.MXID)
+		if err != nil {
+			portal.log.Err(err).Msgf("error joining %s to room", memberPuppet.MXID)
+		}
+	}
+	for _, userID := range change.DeleteMembers {
+		if userID == portalMessage.user.SignalID {
+			continue
+		}
+		mxid := portal.bridge.FormatPuppetMXID(userID)
+		var err error
+		if userID == change.Editor {
+			_, err = portal.bridge.AS.Intent(mxid).LeaveRoom(portal.MXID)
+		} else {
+			err = portal.withFallbackIntent(intent, func(intent *appservice.IntentAPI) error {
+				_, err := intent.KickUser(portal.MXID, &mautrix.ReqKickUser{UserID: mxid})
+				return err
+			})
+		}
+		if err != nil {
+			portal.log.Err(err).Msgf("error removing %s from room", mxid)
+		}
+	}
+	for _, pendingMember := range change.AddPendingMembers {
+		if pendingMember.UserId == portalMessage.user.SignalID {
+			continue
+		}
+		mxid := portal.bridge.FormatPuppetMXID(pendingMember.UserId)
+		err := portal.withFallbackIntent(intent, func(intent *appservice.IntentAPI) error {
+			_, err := intent.InviteUser(portal.MXID, &mautrix.ReqInviteUser{UserID: mxid})
+			return err
+		})
+		if err != nil {
+			portal.log.Err(err).Msgf("error inviting %s to room", mxid)
+		}
+	}
+	for _, bannedMember := range change.AddBannedMembers {
+		mxid := portal.bridge.FormatPuppetMXID(bannedMember.UserId)
+		err := portal.withFallbackIntent(intent, func(intent *appservice.IntentAPI) error {
+			_, err := intent.BanUser(portal.MXID, &mautrix.ReqBanUser{UserID: mxid})
+			return err
+		})
+		if err != nil {
+			portal.log.Err(err).Msgf("error banning %s from room", mxid)
+		}
+	}
+	for _, userID := range change.DeleteBannedMembers {
+		mxid := portal.bridge.FormatPuppetMXID(userID)
+		err := portal.withFallbackIntent(intent, func(intent *appservice.IntentAPI) error {
+			_, err := intent.UnbanUser(portal.MXID, &mautrix.ReqUnbanUser{UserID: mxid})
+			return err
+		})
+		if err != nil {
+			portal.log.Err(err).Msgf("error unbanning %s from room", mxid)
+		}
+	}
+
+	// Revoked invites, role and access control changes, and anything we didn't bridge individually
+	group, err := signalmeow.RetrieveGroupByID(context.Background(), portalMessage.user.SignalDevice, change.GroupIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve group after change: %w", err)
+	}
+	portal.syncGroupMembership(portalMessage.user, group)
+	return nil
+}
+
+// updateGroupAvatar downloads a new group avatar and sets it as the room avatar.
+// An empty avatar path means the avatar was removed.
+func (portal *Portal) updateGroupAvatar(user *User, intent *appservice.IntentAPI, avatarPath string) error {
+	if avatarPath == "" {
+		portal.AvatarURL = id.ContentURI{}
+		portal.AvatarHash = ""
+	} else {
+		_, avatarImage, err := signalmeow.RetrieveGroupAndAvatarByID(context.Background(), user.SignalDevice, signalmeow.GroupIdentifier(portal.ChatID))
+		if err != nil {
+			return err
+		}
+		// avatarImage is only not nil if there's a new avatar to set
+		if avatarImage == nil {
+			return nil
+		}
+		avatarURL, err := portal.MainIntent().UploadBytes(avatarImage, http.DetectContentType(avatarImage))
+		if err != nil {
+			return err
+		}
+		portal.AvatarURL = avatarURL.ContentURI
+		hash := sha256.Sum256(avatarImage)
+		portal.AvatarHash = hex.EncodeToString(hash[:])
+	}
+	_, err := intent.SetRoomAvatar(portal.MXID, portal.AvatarURL)
+	portal.AvatarSet = err == nil
 	return err
 }
 
@@ -1106,6 +1689,36 @@ func (portal *Portal) handleSignalCallMessage(portalMessage portalSignalMessage,
 	return nil
 }
 
+// handleSignalCallEventMessage notes in the chat that a call was answered, declined or started on another device
+func (portal *Portal) handleSignalCallEventMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	callEvent := (portalMessage.message).(signalmeow.IncomingSignalMessageCallEvent)
+	callType := "voice"
+	if callEvent.Video {
+		callType = "video"
+	}
+	var message string
+	switch callEvent.Event {
+	case signalmeow.CallEventTypeAccepted:
+		if callEvent.Outgoing {
+			message = fmt.Sprintf("Started %s call on another device", callType)
+		} else {
+			message = fmt.Sprintf("Answered incoming %s call on another device", callType)
+		}
+	case signalmeow.CallEventTypeNotAccepted:
+		if callEvent.Outgoing {
+			message = fmt.Sprintf("Started %s call on another device, but it wasn't answered", callType)
+		} else {
+			message = fmt.Sprintf("Declined incoming %s call on another device", callType)
+		}
+	default:
+		// Deleting a call only removes it from the call log
+		portal.log.Debug().Msgf("Ignoring call event %d for call %d", callEvent.Event, callEvent.CallID)
+		return nil
+	}
+	_, err := portal.MainIntent().SendNotice(portal.MXID, message)
+	return err
+}
+
 func (portal *Portal) handleSignalReceiptMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
 	receiptMessage := (portalMessage.message).(signalmeow.IncomingSignalMessageReceipt)
 	messageSender := receiptMessage.OriginalSender
@@ -1114,10 +1727,30 @@ func (portal *Portal) handleSignalReceiptMessage(portalMessage portalSignalMessa
 	if dbMessage == nil {
 		return fmt.Errorf("Couldn't find message with Signal ID %s/%d", messageSender, timestamp)
 	}
+	// Receipts from the recipients of our own messages are tracked for message status events
+	isRecipientReceipt := dbMessage.Sender == portalMessage.user.SignalID && receiptMessage.SenderUUID != portalMessage.user.SignalID
 
-	if receiptMessage.ReceiptType == signalmeow.IncomingSignalMessageReceiptTypeRead {
+	if receiptMessage.ReceiptType == signalmeow.IncomingSignalMessageReceiptTypeRead ||
+		receiptMessage.ReceiptType == signalmeow.IncomingSignalMessageReceiptTypeViewed {
 		portal.log.Debug().Msgf("Received read receipt")
 
+		if isRecipientReceipt {
+			wasDelivered := false
+			for _, recipient := range portal.bridge.DB.MessageReceipt.GetDeliveredTo(dbMessage) {
+				wasDelivered = wasDelivered || recipient == receiptMessage.SenderUUID
+			}
+			portal.bridge.DB.MessageReceipt.MarkRead(dbMessage, receiptMessage.SenderUUID, receiptMessage.Timestamp)
+			// Reading the message means it was delivered too, in case the delivery receipt got lost
+			if !wasDelivered {
+				portal.sendMessageDeliveredStatus(dbMessage)
+			}
+		}
+
+		// We read the chat on another device, so disappearing messages start disappearing
+		if receiptMessage.SenderUUID == portalMessage.user.SignalID {
+			portal.startDisappearingTimers(dbMessage.Timestamp)
+		}
+
 		// Don't process read receipts for messages older than the latest one we've seen
 		if receiptMessage.OriginalTimestamp <= portal.latestReadTimestamp {
 			portal.log.Debug().Msgf("Ignoring read receipt for timestamp %d", receiptMessage.OriginalTimestamp)
@@ -1135,7 +1768,10 @@ func (portal *Portal) handleSignalReceiptMessage(portalMessage portalSignalMessa
 
 	} else if receiptMessage.ReceiptType == signalmeow.IncomingSignalMessageReceiptTypeDelivery {
 		portal.log.Debug().Msgf("Received delivery receipt")
-		// Only send delivery MSS for DMs, not groups
+		if !isRecipientReceipt {
+			return nil
+		}
+		// Only send delivery checkpoints for DMs, not groups
 		if portal.IsPrivateChat() {
 			time := jsontime.UMInt(int64(receiptMessage.Timestamp))
 			portal.bridge.SendRawMessageCheckpoint(&status.MessageCheckpoint{
@@ -1146,12 +1782,23 @@ func (portal *Portal) handleSignalReceiptMessage(portalMessage portalSignalMessa
 				Status:     status.MsgStatusDelivered,
 				ReportedBy: status.MsgReportedByBridge,
 			})
-			portal.sendStatusEvent(dbMessage.MXID, "", nil, &[]id.UserID{portal.MainIntent().UserID})
 		}
+		portal.bridge.DB.MessageReceipt.MarkDelivered(dbMessage, receiptMessage.SenderUUID, receiptMessage.Timestamp)
+		portal.sendMessageDeliveredStatus(dbMessage)
 	}
 	return nil
 }
 
+// sendMessageDeliveredStatus sends a message status event listing the Signal users who have received the message
+func (portal *Portal) sendMessageDeliveredStatus(dbMessage *database.Message) {
+	recipients := portal.bridge.DB.MessageReceipt.GetDeliveredTo(dbMessage)
+	deliveredTo := make([]id.UserID, len(recipients))
+	for i, recipient := range recipients {
+		deliveredTo[i] = portal.bridge.FormatPuppetMXID(recipient)
+	}
+	portal.sendStatusEvent(dbMessage.MXID, "", nil, &deliveredTo)
+}
+
 func (portal *Portal) SetReadMarkers(dbMessage *database.Message, sender *Puppet) error {
 	puppetIntent := sender.IntentFor(portal)
 	// Gotta build some custom JSON that isn't in mautrix yet
@@ -1219,7 +1866,7 @@ OuterPrev:
 func (portal *Portal) setTyping(userIDs []id.UserID, isTyping bool) {
 	for _, userID := range userIDs {
 		user := portal.bridge.GetUserByMXID(userID)
-		if user == nil || !user.IsLoggedIn() {
+		if user == nil || !user.IsLoggedIn() || user.typingIndicatorsDisabled.Load() {
 			continue
 		}
 		recipientSignalID := portal.ChatID
@@ -1255,15 +1902,24 @@ func (portal *Portal) HandleMatrixTyping(newTyping []id.UserID) {
 // mautrix-go ReadReceiptHandlingPortal interface
 func (portal *Portal) HandleMatrixReadReceipt(sender bridge.User, eventID id.EventID, receipt event.ReadReceipt) {
 	portal.log.Debug().Msgf("Received read receipt for event %s", eventID)
+	if portal.IsStories() {
+		return
+	}
 	// Find event in the DB
 	dbMessage := portal.bridge.DB.Message.GetByMXID(eventID)
 	if dbMessage == nil {
 		portal.log.Info().Msgf("Read receipt: Couldn't find message with event ID %s", eventID)
 		return
 	}
+	receiptSender := sender.(*User)
+	if receiptSender.readReceiptsDisabled.Load() {
+		// Read receipts are turned off in the Signal privacy settings, but reading still has local effects
+		portal.startDisappearingTimers(dbMessage.Timestamp)
+		portal.openViewOnceMessages(receiptSender, dbMessage.Timestamp)
+		return
+	}
 	msg := signalmeow.ReadReceptMessageForTimestamps([]uint64{dbMessage.Timestamp})
 	receiptDestination := dbMessage.Sender
-	receiptSender := sender.(*User)
 
 	// Don't use portal.sendSignalMessage because we're sending this straight to
 	// who sent the original message, not the portal's ChatID
@@ -1276,6 +1932,8 @@ func (portal *Portal) HandleMatrixReadReceipt(sender bridge.User, eventID id.Eve
 		return
 	}
 	portal.log.Debug().Msgf("Sent read receipt for event %s to Signal %s", eventID, receiptDestination)
+	portal.startDisappearingTimers(dbMessage.Timestamp)
+	portal.openViewOnceMessages(receiptSender, dbMessage.Timestamp)
 }
 
 func (portal *Portal) handleSignalImageMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
@@ -1293,9 +1951,21 @@ func (portal *Portal) handleSignalImageMessage(portalMessage portalSignalMessage
 			// TODO: bridge blurhash! (needs mautrix-go update)
 		},
 	}
+	if content.FileName == "" {
+		content.FileName = attachmentFileName(content.MsgType, content.Info.MimeType)
+	}
+	if content.Body == "" {
+		// Album images don't have their own caption
+		content.Body = content.FileName
+	}
 	portal.addSignalQuote(content, msg.Quote)
-	portal.addMentionsToMatrixBody(content, msg.Mentions)
-	err := portal.uploadMediaToMatrix(intent, msg.Image, content)
+	portal.addFormattingToMatrixBody(content, msg.Mentions, msg.Styles)
+	file, err := portal.downloadSignalAttachment(msg.Attachment)
+	if err != nil {
+		return portal.handleSignalAttachmentFailure(portalMessage, intent, err)
+	}
+	defer removeTempFile(file)
+	err = portal.uploadMediaFileToMatrix(intent, file, content)
 	if err != nil {
 		if errors.Is(err, mautrix.MTooLarge) {
 			//return portal.makeMediaBridgeFailureMessage(info, errors.New("homeserver rejected too large file"), converted, nil, "")
@@ -1313,13 +1983,18 @@ func (portal *Portal) handleSignalImageMessage(portalMessage portalSignalMessage
 	if resp.EventID == "" {
 		return errors.New("Didn't receive event ID from Matrix")
 	}
-	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, timestamp)
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, timestamp, portalMessage.message.Base().PartIndex)
+	portal.markSignalMessageDisappearing(portalMessage, resp.EventID)
 	return err
 }
 
 func (portal *Portal) handleSignalReactionMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) (bool, error) {
 	msg := (portalMessage.message).(signalmeow.IncomingSignalMessageReaction)
 	portal.log.Debug().Msgf("Reaction message received from %s (group: %v) at %v", msg.SenderUUID, msg.GroupID, msg.Timestamp)
+	if msg.StoryContext != nil {
+		// The story is in the stories room, so the reaction is sent as a message quoting it instead
+		return false, portal.handleSignalStoryReaction(portalMessage, intent)
+	}
 	portal.log.Debug().Msgf("Incoming Reaction details: remove: %v, target author: %v, target timestamp: %d", msg.Remove, msg.TargetAuthorUUID, msg.TargetMessageTimestamp)
 
 	matrixEmoji := variationselector.Add(msg.Emoji) // Add variation selector for Matrix
@@ -1365,6 +2040,7 @@ func (portal *Portal) handleSignalReactionMessage(portalMessage portalSignalMess
 			portalMessage.sender.SignalID,
 			msg.TargetAuthorUUID,
 			msg.TargetMessageTimestamp,
+			dbMessage.PartIndex,
 			msg.Emoji, // Store without variation selector, as they come from Signal
 		)
 		return false, err
@@ -1384,22 +2060,103 @@ func (portal *Portal) handleSignalDeleteMessage(portalMessage portalSignalMessag
 	msg := (portalMessage.message).(signalmeow.IncomingSignalMessageDelete)
 	portal.log.Debug().Msgf("Delete message received from %s (group: %v) at %v", msg.SenderUUID, msg.GroupID, msg.Timestamp)
 
-	// Find the event ID of the message to delete
-	dbMessage := portal.bridge.DB.Message.FindBySenderAndTimestamp(msg.SenderUUID, msg.TargetMessageTimestamp)
-	if dbMessage == nil {
+	// Find the event IDs of all parts of the message to delete
+	dbMessages := portal.bridge.DB.Message.GetAllPartsBySignalID(msg.SenderUUID, msg.TargetMessageTimestamp, portal.ChatID, portal.Receiver)
+	if len(dbMessages) == 0 {
 		portal.log.Warn().Msgf("Couldn't find message with Signal ID %s/%d", msg.SenderUUID, msg.TargetMessageTimestamp)
 		return fmt.Errorf("couldn't find message with Signal ID %s/%d", msg.SenderUUID, msg.TargetMessageTimestamp)
 	}
-	_, err := intent.RedactEvent(portal.MXID, dbMessage.MXID)
+	for _, dbMessage := range dbMessages {
+		_, err := intent.RedactEvent(portal.MXID, dbMessage.MXID)
+		if err != nil {
+			portal.log.Warn().Msgf("Failed to redact existing reaction: %v", err)
+			return err
+		}
+	}
+	dbMessages[0].Delete(nil)
+
+	return nil
+}
+
+// handleSignalEditMessage replaces the text of a bridged message with an m.replace event
+func (portal *Portal) handleSignalEditMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	msg := (portalMessage.message).(signalmeow.IncomingSignalMessageEdit)
+	portal.log.Debug().Msgf("Edit of message %d received from %s (group: %v) at %v", msg.TargetMessageTimestamp, msg.SenderUUID, msg.GroupID, msg.Timestamp)
+
+	// Edits of an edited message target the previous edit
+	originalTimestamp := portal.bridge.DB.MessageEdit.GetOriginalTimestamp(portal.ChatID, portal.Receiver, msg.SenderUUID, msg.TargetMessageTimestamp)
+	var target *database.Message
+	for _, part := range portal.bridge.DB.Message.GetAllPartsBySignalID(msg.SenderUUID, originalTimestamp, portal.ChatID, portal.Receiver) {
+		if part.PartIndex == msg.PartIndex {
+			target = part
+			break
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("couldn't find part %d of message with Signal ID %s/%d", msg.PartIndex, msg.SenderUUID, originalTimestamp)
+	}
+	content := &event.MessageEventContent{
+		MsgType: event.MsgText,
+		Body:    msg.Content,
+	}
+	if msg.Caption {
+		// The new content of a caption edit has to include the media too
+		media, err := portal.getMatrixMessageContent(target.MXID)
+		if err != nil {
+			return fmt.Errorf("failed to get media event of caption edit: %w", err)
+		}
+		content.MsgType = media.MsgType
+		content.URL = media.URL
+		content.File = media.File
+		content.Info = media.Info
+		content.FileName = media.FileName
+		if content.FileName == "" {
+			content.FileName = media.Body
+		}
+		if content.Body == "" {
+			content.Body = content.FileName
+		}
+	}
+	portal.addFormattingToMatrixBody(content, msg.Mentions, msg.Styles)
+	content.SetEdit(target.MXID)
+	_, err := portal.sendMatrixMessage(intent, event.EventMessage, content, nil, 0)
 	if err != nil {
-		portal.log.Warn().Msgf("Failed to redact existing reaction: %v", err)
 		return err
 	}
-	dbMessage.Delete(nil)
-
+	portal.bridge.DB.MessageEdit.Insert(portal.ChatID, portal.Receiver, msg.SenderUUID, originalTimestamp, msg.Timestamp)
 	return nil
 }
 
+// getMatrixMessageContent fetches a message event from the portal room, decrypting it if necessary
+func (portal *Portal) getMatrixMessageContent(eventID id.EventID) (*event.MessageEventContent, error) {
+	evt, err := portal.MainIntent().GetEvent(portal.MXID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if evt.Type == event.EventEncrypted {
+		if portal.bridge.Crypto == nil {
+			return nil, errors.New("event is encrypted, but encryption is disabled")
+		}
+		err = evt.Content.ParseRaw(evt.Type)
+		if err != nil {
+			return nil, err
+		}
+		evt, err = portal.bridge.Crypto.Decrypt(evt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt event: %w", err)
+		}
+	}
+	err = evt.Content.ParseRaw(evt.Type)
+	if err != nil && !errors.Is(err, event.ErrContentAlreadyParsed) {
+		return nil, err
+	}
+	content, ok := evt.Content.Parsed.(*event.MessageEventContent)
+	if !ok {
+		return nil, fmt.Errorf("unexpected content type %T", evt.Content.Parsed)
+	}
+	return content, nil
+}
+
 func (portal *Portal) sendMainIntentMessage(content *event.MessageEventContent) (*mautrix.RespSendEvent, error) {
 	return portal.sendMatrixMessage(portal.MainIntent(), event.EventMessage, content, nil, 0)
 }
@@ -1432,8 +2189,210 @@ func (portal *Portal) encryptFileInPlace(data []byte, mimeType string) (string,
 	return "application/octet-stream", file
 }
 
-func (portal *Portal) uploadMediaToMatrix(intent *appservice.IntentAPI, data []byte, content *event.MessageEventContent) error {
-	uploadMimeType, file := portal.encryptFileInPlace(data, content.Info.MimeType)
+// handleSignalAttachmentMessage bridges videos, audio, voice notes and other files
+func (portal *Portal) handleSignalAttachmentMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	timestamp := portalMessage.message.Base().Timestamp
+	var attachment *signalmeow.AttachmentPointer
+	var thumbnail []byte
+	var caption string
+	var quote *signalmeow.IncomingSignalMessageQuoteData
+	var mentions []signalmeow.IncomingSignalMessageMentionData
+	var styles []signalmeow.StyleRange
+	var extraContent map[string]interface{}
+	content := &event.MessageEventContent{Info: &event.FileInfo{}}
+	switch msg := portalMessage.message.(type) {
+	case signalmeow.IncomingSignalMessageVideo:
+		content.MsgType = event.MsgVideo
+		content.FileName = msg.Filename
+		content.Info.MimeType = msg.ContentType
+		content.Info.Size = int(msg.Size)
+		content.Info.Width = int(msg.Width)
+		content.Info.Height = int(msg.Height)
+		attachment, thumbnail, caption = msg.Attachment, msg.Thumbnail, msg.Caption
+		quote, mentions, styles = msg.Quote, msg.Mentions, msg.Styles
+		if msg.GIF {
+			extraContent = map[string]interface{}{"info": map[string]interface{}{"fi.mau.gif": true, "fi.mau.loop": true, "fi.mau.autoplay": true, "fi.mau.hide_controls": true, "fi.mau.no_audio": true}}
+		}
+	case signalmeow.IncomingSignalMessageAudio:
+		content.MsgType = event.MsgAudio
+		content.FileName = msg.Filename
+		content.Info.MimeType = msg.ContentType
+		content.Info.Size = int(msg.Size)
+		attachment, caption = msg.Attachment, msg.Caption
+		quote, mentions, styles = msg.Quote, msg.Mentions, msg.Styles
+	case signalmeow.IncomingSignalMessageVoiceNote:
+		content.MsgType = event.MsgAudio
+		content.FileName = msg.Filename
+		content.Info.MimeType = msg.ContentType
+		content.Info.Size = int(msg.Size)
+		attachment = msg.Attachment
+		quote, mentions, styles = msg.Quote, msg.Mentions, msg.Styles
+	case signalmeow.IncomingSignalMessageFile:
+		content.MsgType = event.MsgFile
+		content.FileName = msg.Filename
+		content.Info.MimeType = msg.ContentType
+		content.Info.Size = int(msg.Size)
+		attachment, thumbnail, caption = msg.Attachment, msg.Thumbnail, msg.Caption
+		quote, mentions, styles = msg.Quote, msg.Mentions, msg.Styles
+	default:
+		return fmt.Errorf("unexpected attachment message type %T", msg)
+	}
+	if content.FileName == "" {
+		content.FileName = attachmentFileName(content.MsgType, content.Info.MimeType)
+	}
+	content.Body = caption
+	if content.Body == "" {
+		content.Body = content.FileName
+	}
+	portal.addSignalQuote(content, quote)
+	portal.addFormattingToMatrixBody(content, mentions, styles)
+
+	file, err := portal.downloadSignalAttachment(attachment)
+	if err != nil {
+		return portal.handleSignalAttachmentFailure(portalMessage, intent, err)
+	}
+	defer removeTempFile(file)
+	if content.MsgType == event.MsgVideo || content.MsgType == event.MsgAudio {
+		// Signal doesn't include the duration, so it has to be read from the file itself
+		content.Info.Duration = getMediaDuration(context.Background(), file.Name())
+	}
+	if portalMessage.message.MessageType() == signalmeow.IncomingSignalMessageTypeVoiceNote {
+		extraContent = map[string]interface{}{
+			"org.matrix.msc1767.audio": map[string]interface{}{
+				"duration": content.Info.Duration,
+			},
+			"org.matrix.msc3245.voice": map[string]interface{}{},
+		}
+	}
+
+	if len(thumbnail) > 0 {
+		err = portal.uploadThumbnailToMatrix(intent, thumbnail, content)
+		if err != nil {
+			portal.log.Warn().Err(err).Msg("Failed to upload thumbnail")
+		}
+	}
+	err = portal.uploadMediaFileToMatrix(intent, file, content)
+	if err != nil {
+		portal.log.Error().Err(err).Msg("Failed to upload media")
+	}
+	resp, err := portal.sendMatrixMessage(intent, event.EventMessage, content, extraContent, 0)
+	if err != nil {
+		return err
+	}
+	if resp.EventID == "" {
+		return errors.New("Didn't receive event ID from Matrix")
+	}
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, timestamp, portalMessage.message.Base().PartIndex)
+	portal.markSignalMessageDisappearing(portalMessage, resp.EventID)
+	return err
+}
+
+// attachmentFileName makes up a file name for attachments sent without one, like voice notes
+func attachmentFileName(msgType event.MessageType, mimeType string) string {
+	name := "file"
+	switch msgType {
+	case event.MsgImage:
+		name = "image"
+	case event.MsgVideo:
+		name = "video"
+	case event.MsgAudio:
+		name = "audio"
+	}
+	if exts, _ := mime.ExtensionsByType(mimeType); len(exts) > 0 {
+		name += exts[0]
+	}
+	return name
+}
+
+// getMediaDuration reads the duration of an audio or video file in milliseconds using ffprobe.
+// Returns 0 if ffprobe isn't installed or the duration can't be read.
+func getMediaDuration(ctx context.Context, path string) int {
+	ffprobePath, err := exec.LookPath("ffprobe")
+	if err != nil {
+		return 0
+	}
+	cmd := exec.CommandContext(ctx, ffprobePath, "-v", "quiet", "-show_entries", "format=duration", "-of", "csv=p=0", path)
+	output, err := cmd.Output()
+	if err != nil {
+		return 0
+	}
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(string(output)), 64)
+	if err != nil {
+		return 0
+	}
+	return int(seconds * 1000)
+}
+
+// uploadThumbnailToMatrix uploads the inline thumbnail of an attachment and adds it to the content info
+func (portal *Portal) uploadThumbnailToMatrix(intent *appservice.IntentAPI, thumbnail []byte, content *event.MessageEventContent) error {
+	mimeType := http.DetectContentType(thumbnail)
+	if !strings.HasPrefix(mimeType, "image/") {
+		return fmt.Errorf("%w %q in thumbnail", errMediaUnsupportedType, mimeType)
+	}
+	cfg, _, _ := image.DecodeConfig(bytes.NewReader(thumbnail))
+	thumbnailInfo := &event.FileInfo{
+		MimeType: mimeType,
+		Size:     len(thumbnail),
+		Width:    cfg.Width,
+		Height:   cfg.Height,
+	}
+	url, file, err := portal.uploadToMatrix(intent, thumbnail, mimeType)
+	if err != nil {
+		return err
+	}
+	content.Info.ThumbnailInfo = thumbnailInfo
+	content.Info.ThumbnailURL = url
+	content.Info.ThumbnailFile = file
+	return nil
+}
+
+// handleSignalAttachmentFailure sends a notice in place of an attachment that couldn't be downloaded from Signal
+func (portal *Portal) handleSignalAttachmentFailure(portalMessage portalSignalMessage, intent *appservice.IntentAPI, downloadErr error) error {
+	portal.log.Err(downloadErr).Msg("Failed to download attachment from Signal")
+	content := &event.MessageEventContent{
+		MsgType: event.MsgNotice,
+		Body:    fmt.Sprintf("Failed to bridge attachment: %v", downloadErr),
+	}
+	resp, err := portal.sendMatrixMessage(intent, event.EventMessage, content, nil, 0)
+	if err != nil {
+		return err
+	}
+	base := portalMessage.message.Base()
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, base.Timestamp, base.PartIndex)
+	portal.markSignalMessageDisappearing(portalMessage, resp.EventID)
+	return nil
+}
+
+// downloadSignalAttachment downloads and decrypts a Signal attachment into a temp file, which
+// the caller must get rid of with removeTempFile
+func (portal *Portal) downloadSignalAttachment(attachment *signalmeow.AttachmentPointer) (*os.File, error) {
+	file, err := os.CreateTemp("", "mautrix-signal-media-*")
+	if err != nil {
+		return nil, err
+	}
+	err = signalmeow.DownloadAttachment(attachment, file, portal.bridge.Config.Bridge.MaxAttachmentSize())
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		removeTempFile(file)
+		if errors.Is(err, signalmeow.ErrAttachmentTooLarge) {
+			return nil, exerrors.NewDualError(errMediaTooLarge, err)
+		}
+		return nil, exerrors.NewDualError(errMediaDownloadFailed, err)
+	}
+	return file, nil
+}
+
+func removeTempFile(file *os.File) {
+	_ = file.Close()
+	_ = os.Remove(file.Name())
+}
+
+// uploadToMatrix encrypts the data if the portal is encrypted and uploads it.
+// If it was encrypted, the returned file info must be used instead of the URI.
+func (portal *Portal) uploadToMatrix(intent *appservice.IntentAPI, data []byte, mimeType string) (id.ContentURIString, *event.EncryptedFileInfo, error) {
+	uploadMimeType, file := portal.encryptFileInPlace(data, mimeType)
 
 	req := mautrix.ReqUploadMedia{
 		ContentBytes: data,
@@ -1443,22 +2402,33 @@ func (portal *Portal) uploadMediaToMatrix(intent *appservice.IntentAPI, data []b
 	if portal.bridge.Config.Homeserver.AsyncMedia {
 		uploaded, err := intent.UploadAsync(req)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 		mxc = uploaded.ContentURI
 	} else {
 		uploaded, err := intent.UploadMedia(req)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 		mxc = uploaded.ContentURI
 	}
 
 	if file != nil {
 		file.URL = mxc.CUString()
+		return "", file, nil
+	}
+	return mxc.CUString(), nil, nil
+}
+
+func (portal *Portal) uploadMediaToMatrix(intent *appservice.IntentAPI, data []byte, content *event.MessageEventContent) error {
+	url, file, err := portal.uploadToMatrix(intent, data, content.Info.MimeType)
+	if err != nil {
+		return err
+	}
+	if file != nil {
 		content.File = file
 	} else {
-		content.URL = mxc.CUString()
+		content.URL = url
 	}
 
 	content.Info.Size = len(data)
@@ -1466,18 +2436,71 @@ func (portal *Portal) uploadMediaToMatrix(intent *appservice.IntentAPI, data []b
 		cfg, _, _ := image.DecodeConfig(bytes.NewReader(data))
 		content.Info.Width, content.Info.Height = cfg.Width, cfg.Height
 	}
+	addImageThumbnail(content)
+	return nil
+}
 
-	// This is a hack for bad clients like Element iOS that require a thumbnail (https://github.com/vector-im/element-ios/issues/4004)
+// uploadMediaFileToMatrix streams a file to the Matrix media repo, encrypting it on the way if needed.
+// The upload is never async, as the file is removed as soon as the event is sent.
+func (portal *Portal) uploadMediaFileToMatrix(intent *appservice.IntentAPI, file *os.File, content *event.MessageEventContent) error {
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if content.Info.Width == 0 && content.Info.Height == 0 && strings.HasPrefix(content.Info.MimeType, "image/") {
+		cfg, _, _ := image.DecodeConfig(file)
+		content.Info.Width, content.Info.Height = cfg.Width, cfg.Height
+		if _, err = file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	req := mautrix.ReqUploadMedia{
+		Content:       file,
+		ContentLength: stat.Size(),
+		ContentType:   content.Info.MimeType,
+	}
+	var encryptedFile *event.EncryptedFileInfo
+	var encryptStream io.ReadCloser
+	if portal.Encrypted {
+		encryptedFile = &event.EncryptedFileInfo{
+			EncryptedFile: *attachment.NewEncryptedFile(),
+		}
+		encryptStream = encryptedFile.EncryptStream(file)
+		req.Content = encryptStream
+		req.ContentType = "application/octet-stream"
+	}
+	uploaded, err := intent.UploadMedia(req)
+	if err != nil {
+		return err
+	}
+	if encryptStream != nil {
+		// Closing fills in the hash of the encrypted file
+		if err = encryptStream.Close(); err != nil {
+			return err
+		}
+		encryptedFile.URL = uploaded.ContentURI.CUString()
+		content.File = encryptedFile
+	} else {
+		content.URL = uploaded.ContentURI.CUString()
+	}
+
+	content.Info.Size = int(stat.Size())
+	addImageThumbnail(content)
+	return nil
+}
+
+// addImageThumbnail is a hack for bad clients like Element iOS that require a thumbnail (https://github.com/vector-im/element-ios/issues/4004)
+func addImageThumbnail(content *event.MessageEventContent) {
 	if strings.HasPrefix(content.Info.MimeType, "image/") && content.Info.ThumbnailInfo == nil {
 		infoCopy := *content.Info
 		content.Info.ThumbnailInfo = &infoCopy
 		if content.File != nil {
-			content.Info.ThumbnailFile = file
+			content.Info.ThumbnailFile = content.File
 		} else {
 			content.Info.ThumbnailURL = content.URL
 		}
 	}
-	return nil
 }
 
 // Boilerplate to send different event types with a modicum of type safety
@@ -1569,6 +2592,11 @@ func (portal *Portal) CreateMatrixRoom(user *User, meta *any) error {
 		return err
 	}
 
+	if portal.IsStories() {
+		portal.Name = storiesRoomName
+		portal.Topic = storiesRoomTopic
+	}
+
 	bridgeInfoStateKey, bridgeInfo := portal.getBridgeInfo()
 	initialState := []*event.Event{{
 		Type:     event.StateBridge,
@@ -1722,7 +2750,7 @@ func (br *SignalBridge) GetPortalByChatID(key database.PortalKey) *Portal {
 	br.portalsLock.Lock()
 	defer br.portalsLock.Unlock()
 	// If this PortalKey is for a group, Receiver should be empty
-	if !isUUID(key.ChatID) {
+	if !isUUID(key.ChatID) && key.ChatID != storiesChatID {
 		key.Receiver = ""
 	}
 	portal, ok := br.portalsByID[key]
@@ -1732,6 +2760,56 @@ func (br *SignalBridge) GetPortalByChatID(key database.PortalKey) *Portal {
 	return portal
 }
 
+// Delete removes the portal from the database and the bridge's portal cache.
+// The Matrix room should be cleaned up with Cleanup first.
+func (portal *Portal) Delete() {
+	err := portal.Portal.Delete()
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to delete portal from database")
+	}
+	portal.bridge.portalsLock.Lock()
+	delete(portal.bridge.portalsByID, portal.Key())
+	if len(portal.MXID) > 0 {
+		delete(portal.bridge.portalsByMXID, portal.MXID)
+	}
+	portal.bridge.portalsLock.Unlock()
+}
+
+// Cleanup makes everyone leave the portal room: puppets leave by themselves and Matrix users are kicked,
+// unless puppetsOnly is set. Finally the main intent of the portal leaves too.
+func (portal *Portal) Cleanup(puppetsOnly bool) {
+	if len(portal.MXID) == 0 {
+		return
+	}
+	intent := portal.MainIntent()
+	members, err := intent.JoinedMembers(portal.MXID)
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to get portal members for cleanup")
+		return
+	}
+	for member := range members.Joined {
+		if member == intent.UserID {
+			continue
+		}
+		puppet := portal.bridge.GetPuppetByMXID(member)
+		if puppet != nil {
+			_, err = puppet.DefaultIntent().LeaveRoom(portal.MXID)
+			if err != nil {
+				portal.log.Err(err).Msgf("Failed to leave as puppet %s while cleaning up portal", member)
+			}
+		} else if !puppetsOnly {
+			_, err = intent.KickUser(portal.MXID, &mautrix.ReqKickUser{UserID: member, Reason: "Deleting portal"})
+			if err != nil {
+				portal.log.Err(err).Msgf("Failed to kick %s from portal", member)
+			}
+		}
+	}
+	_, err = intent.LeaveRoom(portal.MXID)
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to leave portal room after cleanup")
+	}
+}
+
 func (portal *Portal) getBridgeInfoStateKey() string {
 	return fmt.Sprintf("net.maunium.signal://signal/%s", portal.ChatID)
 }
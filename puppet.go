@@ -30,6 +30,14 @@ type Puppet struct {
 
 var userIDRegex *regexp.Regexp
 
+// Where a puppet's name or avatar came from, stored as name_quality and avatar_quality.
+// A value from a better source isn't replaced by one from a worse source.
+const (
+	puppetQualityNone    = iota
+	puppetQualityContact // The contact list of the primary device
+	puppetQualityProfile // The Signal profile of the user
+)
+
 var _ bridge.Ghost = (*Puppet)(nil)
 var _ bridge.GhostWithProfile = (*Puppet)(nil)
 
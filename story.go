@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"go.mau.fi/util/variationselector"
+	"maunium.net/go/mautrix/appservice"
+	"maunium.net/go/mautrix/event"
+
+	"go.mau.fi/mautrix-signal/database"
+	"go.mau.fi/mautrix-signal/pkg/signalmeow"
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+)
+
+// Stories of all contacts and groups are bridged into one room per user, which has this chat ID
+const storiesChatID = "stories"
+
+const (
+	storiesRoomName  = "Signal Stories"
+	storiesRoomTopic = "Stories posted by your Signal contacts and groups"
+)
+
+// Signal stories disappear a day after they're posted
+const storyLifetime = 24 * time.Hour
+
+// How much of a story is shown when quoting it in a reply
+const storyQuoteMaxLength = 100
+
+const signalStoryKey = "fi.mau.signal.story"
+
+func (portal *Portal) IsStories() bool {
+	return portal.ChatID == storiesChatID
+}
+
+func (portal *Portal) handleSignalStoryMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	msg := (portalMessage.message).(signalmeow.IncomingSignalMessageStory)
+	portal.log.Debug().Msgf("Story received from %s (group: %v) at %v", msg.SenderUUID, msg.GroupID, msg.Timestamp)
+	remaining := storyLifetime - time.Since(time.UnixMilli(int64(msg.Timestamp)))
+	if remaining <= 0 {
+		portal.log.Debug().Msgf("Not bridging expired story from %s at %v", msg.SenderUUID, msg.Timestamp)
+		return nil
+	}
+
+	var content *event.MessageEventContent
+	var extraContent map[string]interface{}
+	if msg.TextAttachment != nil {
+		content, extraContent = portal.convertSignalTextStory(intent, msg.TextAttachment)
+	} else {
+		var err error
+		content, err = portal.convertSignalFileStory(intent, msg)
+		if err != nil {
+			return portal.handleSignalAttachmentFailure(portalMessage, intent, err)
+		}
+	}
+	resp, err := portal.sendMatrixMessage(intent, event.EventMessage, content, extraContent, 0)
+	if err != nil {
+		return err
+	}
+	if resp.EventID == "" {
+		return errors.New("Didn't receive event ID from Matrix")
+	}
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, msg.Timestamp, 0)
+	portal.MarkDisappearing(resp.EventID, uint32(remaining.Seconds()), true)
+	return nil
+}
+
+func (portal *Portal) convertSignalFileStory(intent *appservice.IntentAPI, msg signalmeow.IncomingSignalMessageStory) (*event.MessageEventContent, error) {
+	content := &event.MessageEventContent{
+		MsgType:  event.MsgFile,
+		Body:     msg.Caption,
+		FileName: msg.Filename,
+		Info: &event.FileInfo{
+			MimeType: msg.ContentType,
+			Size:     int(msg.Size),
+			Width:    int(msg.Width),
+			Height:   int(msg.Height),
+		},
+	}
+	switch {
+	case strings.HasPrefix(msg.ContentType, "image"):
+		content.MsgType = event.MsgImage
+	case strings.HasPrefix(msg.ContentType, "video"):
+		content.MsgType = event.MsgVideo
+	}
+	if content.FileName == "" {
+		content.FileName = attachmentFileName(content.MsgType, content.Info.MimeType)
+	}
+	if content.Body == "" {
+		content.Body = content.FileName
+	} else {
+		portal.addFormattingToMatrixBody(content, msg.Mentions, msg.Styles)
+	}
+
+	file, err := portal.downloadSignalAttachment(msg.Attachment)
+	if err != nil {
+		return nil, err
+	}
+	defer removeTempFile(file)
+	if content.MsgType == event.MsgVideo {
+		content.Info.Duration = getMediaDuration(context.Background(), file.Name())
+	}
+	err = portal.uploadMediaFileToMatrix(intent, file, content)
+	if err != nil {
+		portal.log.Error().Err(err).Msg("Failed to upload story media")
+	}
+	return content, nil
+}
+
+// convertSignalTextStory turns a text story into a text message in the colors of the story.
+// The full styling is included in the extra content, so clients can render the story like Signal does.
+func (portal *Portal) convertSignalTextStory(intent *appservice.IntentAPI, story *signalmeow.StoryTextAttachment) (*event.MessageEventContent, map[string]interface{}) {
+	content := &event.MessageEventContent{
+		MsgType: event.MsgText,
+		Body:    story.Text,
+		Format:  event.FormatHTML,
+	}
+	backgroundColor := story.BackgroundColor
+	if backgroundColor == 0 {
+		backgroundColor = story.Color
+		if story.Gradient != nil && len(story.Gradient.Colors) > 0 {
+			backgroundColor = story.Gradient.Colors[0]
+		}
+	}
+	formatted := strings.ReplaceAll(html.EscapeString(story.Text), "\n", "<br>")
+	if story.TextStyle == signalpb.TextAttachment_BOLD {
+		formatted = "<strong>" + formatted + "</strong>"
+	}
+	content.FormattedBody = fmt.Sprintf(`<span data-mx-color="%s" data-mx-bg-color="%s">%s</span>`, storyColor(story.ForegroundColor), storyColor(backgroundColor), formatted)
+
+	storyInfo := map[string]interface{}{
+		"text_style":      story.TextStyle.String(),
+		"text_color":      storyColor(story.ForegroundColor),
+		"text_background": storyColor(story.BackgroundColor),
+	}
+	if story.Gradient != nil {
+		colors := make([]string, len(story.Gradient.Colors))
+		for i, color := range story.Gradient.Colors {
+			colors[i] = storyColor(color)
+		}
+		storyInfo["background_gradient"] = map[string]interface{}{
+			"colors":    colors,
+			"positions": story.Gradient.Positions,
+			"angle":     story.Gradient.Angle,
+		}
+	} else {
+		storyInfo["background_color"] = storyColor(story.Color)
+	}
+	extraContent := map[string]interface{}{
+		signalStoryKey: storyInfo,
+	}
+
+	if story.Preview != nil {
+		if !strings.Contains(content.Body, story.Preview.URL) {
+			if content.Body != "" {
+				content.Body += "\n\n"
+				content.FormattedBody += "<br><br>"
+			}
+			content.Body += story.Preview.URL
+			content.FormattedBody += fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(story.Preview.URL), html.EscapeString(story.Preview.URL))
+		}
+		extraContent[beeperLinkPreviewsKey] = []*BeeperLinkPreview{portal.convertSignalPreviewToBeeper(intent, *story.Preview)}
+	}
+	return content, extraContent
+}
+
+// storyColor formats an ARGB color from Signal as a hex color code, without the alpha
+func storyColor(color uint32) string {
+	return fmt.Sprintf("#%06x", color&0xffffff)
+}
+
+// handleSignalStoryReaction bridges a reaction to a story as a message quoting the story
+func (portal *Portal) handleSignalStoryReaction(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	msg := (portalMessage.message).(signalmeow.IncomingSignalMessageReaction)
+	if msg.Remove {
+		// Signal clients don't remove story reactions, and there's nothing to redact if they did
+		return nil
+	}
+	content := &event.MessageEventContent{
+		MsgType: event.MsgText,
+		Body:    "Reacted with " + variationselector.Add(msg.Emoji),
+	}
+	portal.addSignalStoryQuote(content, portalMessage.user, msg.StoryContext)
+	resp, err := portal.sendMatrixMessage(intent, event.EventMessage, content, nil, 0)
+	if err != nil {
+		return err
+	}
+	if resp.EventID == "" {
+		return errors.New("Didn't receive event ID from Matrix")
+	}
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, msg.Timestamp, msg.PartIndex)
+	portal.markSignalMessageDisappearing(portalMessage, resp.EventID)
+	return nil
+}
+
+// addSignalStoryQuote puts a quote of the story a message responds to at the start of the message.
+// The story is in the stories room, so a Matrix reply can't be used, but the quote links to it.
+func (portal *Portal) addSignalStoryQuote(content *event.MessageEventContent, user *User, storyContext *signalmeow.IncomingSignalMessageStoryContext) {
+	if storyContext == nil {
+		return
+	}
+	storyName := "a story"
+	if storyContext.StoryAuthorUUID == user.SignalID {
+		storyName = "your story"
+	} else if puppet := portal.bridge.GetPuppetBySignalID(storyContext.StoryAuthorUUID); puppet != nil && puppet.Name != "" {
+		storyName = puppet.Name + "'s story"
+	}
+	quoteHTML := html.EscapeString(storyName)
+	var summary string
+	story := portal.bridge.DB.Message.GetBySignalID(storyContext.StoryAuthorUUID, storyContext.StoryTimestamp, storiesChatID, user.SignalUsername)
+	if story != nil {
+		quoteHTML = fmt.Sprintf(`<a href="%s">%s</a>`, story.MXRoom.EventURI(story.MXID).MatrixToURL(), quoteHTML)
+		summary = portal.getStorySummary(story)
+	} else {
+		portal.log.Debug().Msgf("Couldn't find story %s/%d, quoting without a link", storyContext.StoryAuthorUUID, storyContext.StoryTimestamp)
+	}
+
+	quoteText := "> In reply to " + storyName
+	quoteHTML = "In reply to " + quoteHTML
+	if summary != "" {
+		quoteText += ":\n> " + strings.ReplaceAll(summary, "\n", "\n> ")
+		quoteHTML += ":<br>" + strings.ReplaceAll(html.EscapeString(summary), "\n", "<br>")
+	}
+	if content.Format != event.FormatHTML {
+		content.Format = event.FormatHTML
+		content.FormattedBody = strings.ReplaceAll(html.EscapeString(content.Body), "\n", "<br>")
+	}
+	content.Body = quoteText + "\n\n" + content.Body
+	content.FormattedBody = "<blockquote>" + quoteHTML + "</blockquote>" + content.FormattedBody
+}
+
+// getStorySummary gets the text of a bridged story from the stories room, shortened for quoting
+func (portal *Portal) getStorySummary(story *database.Message) string {
+	evt, err := portal.bridge.Bot.GetEvent(story.MXRoom, story.MXID)
+	if err != nil {
+		portal.log.Warn().Err(err).Msgf("Failed to get story %s", story.MXID)
+		return ""
+	}
+	_ = evt.Content.ParseRaw(evt.Type)
+	if evt.Type == event.EventEncrypted {
+		if portal.bridge.Crypto == nil {
+			return ""
+		}
+		evt, err = portal.bridge.Crypto.Decrypt(evt)
+		if err != nil {
+			portal.log.Warn().Err(err).Msgf("Failed to decrypt story %s", story.MXID)
+			return ""
+		}
+		_ = evt.Content.ParseRaw(evt.Type)
+	}
+	summary := []rune(strings.TrimSpace(evt.Content.AsMessage().Body))
+	if len(summary) > storyQuoteMaxLength {
+		summary = append(summary[:storyQuoteMaxLength], '…')
+	}
+	return string(summary)
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+
+	"maunium.net/go/mautrix"
+	"maunium.net/go/mautrix/appservice"
+	"maunium.net/go/mautrix/pushrules"
+
+	"go.mau.fi/mautrix-signal/database"
+	"go.mau.fi/mautrix-signal/pkg/signalmeow"
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+)
+
+// handleSignalAccountMessage handles sync messages about the account rather than a single chat.
+// It returns false if the message should be routed to a portal like any other message.
+func (user *User) handleSignalAccountMessage(incomingMessage signalmeow.IncomingSignalMessage) bool {
+	switch msg := incomingMessage.(type) {
+	case signalmeow.IncomingSignalMessageContactSync:
+		user.handleSignalContactSync(msg)
+	case signalmeow.IncomingSignalMessageBlocked:
+		user.handleSignalBlocked(msg)
+	case signalmeow.IncomingSignalMessageConfiguration:
+		user.log.Debug().Msgf("Privacy settings synced: read receipts %t, typing indicators %t", msg.ReadReceipts, msg.TypingIndicators)
+		user.readReceiptsDisabled.Store(!msg.ReadReceipts)
+		user.typingIndicatorsDisabled.Store(!msg.TypingIndicators)
+	case signalmeow.IncomingSignalMessageFetchLatest:
+		user.handleSignalFetchLatest(msg)
+	case signalmeow.IncomingSignalMessageMessageRequestResponse:
+		user.handleSignalMessageRequestResponse(msg)
+	case signalmeow.IncomingSignalMessagePniChangeNumber:
+		err := signalmeow.ApplyPniChangeNumber(user.SignalDevice, user.bridge.MeowStore, msg)
+		if err != nil {
+			user.log.Err(err).Msg("Failed to apply new PNI identity after phone number change")
+		} else {
+			user.log.Info().Msg("Applied new PNI identity after phone number change")
+		}
+	case signalmeow.IncomingSignalMessageStickerPackOperation:
+		// Sticker packs are downloaded when a sticker is received, so there's nothing to do here
+		user.log.Debug().Msgf("Sticker pack %x installed or removed (removed: %t)", msg.PackID, msg.Remove)
+	case signalmeow.IncomingSignalMessageKeys:
+		user.log.Debug().Msg("Received storage service key")
+		if len(msg.StorageServiceKey) > 0 {
+			go user.createStorageGroupPortals(msg.StorageServiceKey)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// handleSignalContactSync updates puppets and private chats with the contact list of the primary device
+func (user *User) handleSignalContactSync(msg signalmeow.IncomingSignalMessageContactSync) {
+	user.log.Info().Msgf("Contact list synced with %d contacts", len(msg.Contacts))
+	contacts := msg.Contacts
+	// Chats in the chat list come first, in the order they're shown there
+	sort.SliceStable(contacts, func(i, j int) bool {
+		if (contacts[i].InboxPosition == 0) != (contacts[j].InboxPosition == 0) {
+			return contacts[i].InboxPosition != 0
+		}
+		return contacts[i].InboxPosition < contacts[j].InboxPosition
+	})
+	for _, contact := range contacts {
+		puppet := user.bridge.GetPuppetBySignalID(contact.UUID)
+		if puppet == nil {
+			continue
+		}
+		user.updatePuppetWithSignalContact(puppet, contact)
+		if contact.Blocked {
+			user.blockedChatsLock.Lock()
+			if user.blockedChats == nil {
+				user.blockedChats = make(map[string]bool)
+			}
+			user.blockedChats[contact.UUID] = true
+			user.blockedChatsLock.Unlock()
+		}
+		// Don't create portals for everyone in the contact list, only update the ones that exist
+		if user.bridge.DB.Portal.GetByChatID(database.NewPortalKey(contact.UUID, user.SignalUsername)) == nil {
+			continue
+		}
+		portal := user.GetPortalByChatID(contact.UUID)
+		// An unset timer in the contact list doesn't mean disappearing messages were turned off
+		if contact.ExpireTimer != 0 && portal.ExpirationTime != int(contact.ExpireTimer) {
+			user.log.Debug().Msgf("Updating disappearing message timer with %s to %d seconds from contact list", contact.UUID, contact.ExpireTimer)
+			var err error
+			if portal.MXID != "" {
+				err = portal.updateExpirationTime(portal.MainIntent(), contact.ExpireTimer)
+			} else {
+				portal.ExpirationTime = int(contact.ExpireTimer)
+				err = portal.Update()
+			}
+			if err != nil {
+				user.log.Err(err).Msgf("Failed to update disappearing message timer of %s", contact.UUID)
+			}
+		}
+		if contact.Blocked {
+			if doublePuppet := user.bridge.GetPuppetByCustomMXID(user.MXID); doublePuppet != nil && doublePuppet.CustomIntent() != nil {
+				user.updateChatMute(doublePuppet.CustomIntent(), portal, true)
+			}
+		}
+	}
+	if user.startupContactSyncPending.Swap(false) {
+		go user.createPrivateChatPortals(contacts)
+	}
+}
+
+// updatePuppetWithSignalContact fills in the number, and the name and avatar if the profile doesn't have them.
+// Contact list names are what the user calls the contact, so they're only a fallback for the profile name.
+func (user *User) updatePuppetWithSignalContact(puppet *Puppet, contact signalmeow.SyncedContact) {
+	if contact.Number != "" && (puppet.Number == nil || *puppet.Number != contact.Number) {
+		number := contact.Number
+		puppet.Number = &number
+		err := puppet.UpdateNumber()
+		if err != nil {
+			user.log.Err(err).Msgf("Failed to save number of %s", puppet.SignalID)
+		}
+	}
+	changed := false
+	if contact.Name != "" && contact.Name != puppet.Name && (puppet.Name == "" || puppet.NameQuality == puppetQualityContact) {
+		puppet.Name = contact.Name
+		puppet.NameQuality = puppetQualityContact
+		err := puppet.DefaultIntent().SetDisplayName(contact.Name)
+		if err != nil {
+			user.log.Err(err).Msgf("Failed to set display name of %s from contact list", puppet.SignalID)
+		}
+		puppet.NameSet = err == nil
+		changed = true
+	}
+	if len(contact.Avatar) > 0 && (puppet.AvatarHash == "" || puppet.AvatarQuality == puppetQualityContact) {
+		hash := sha256.Sum256(contact.Avatar)
+		avatarHash := hex.EncodeToString(hash[:])
+		if avatarHash != puppet.AvatarHash {
+			contentType := contact.AvatarContentType
+			if contentType == "" {
+				contentType = http.DetectContentType(contact.Avatar)
+			}
+			avatarURL, err := puppet.DefaultIntent().UploadBytes(contact.Avatar, contentType)
+			if err != nil {
+				user.log.Err(err).Msgf("Failed to upload contact list avatar of %s", puppet.SignalID)
+			} else {
+				puppet.AvatarURL = avatarURL.ContentURI
+				puppet.AvatarHash = avatarHash
+				puppet.AvatarQuality = puppetQualityContact
+				err = puppet.DefaultIntent().SetAvatarURL(avatarURL.ContentURI)
+				if err != nil {
+					user.log.Err(err).Msgf("Failed to set avatar of %s from contact list", puppet.SignalID)
+				}
+				puppet.AvatarSet = err == nil
+				changed = true
+			}
+		}
+	}
+	if changed {
+		err := puppet.Update()
+		if err != nil {
+			user.log.Err(err).Msgf("Failed to save puppet %s", puppet.SignalID)
+		}
+	}
+}
+
+func (user *User) handleSignalFetchLatest(msg signalmeow.IncomingSignalMessageFetchLatest) {
+	switch msg.FetchType {
+	case signalpb.SyncMessage_FetchLatest_LOCAL_PROFILE:
+		user.log.Debug().Msg("Own profile changed, fetching it again")
+		signalmeow.InvalidateProfileCache(user.SignalDevice, user.SignalID)
+		puppet := user.bridge.GetPuppetBySignalID(user.SignalID)
+		if puppet == nil {
+			return
+		}
+		err := updatePuppetWithSignalProfile(context.Background(), user, puppet)
+		if err != nil {
+			user.log.Err(err).Msg("Failed to update own puppet after profile change")
+		}
+	default:
+		user.log.Debug().Msgf("Ignoring fetch latest request of type %s", msg.FetchType)
+	}
+}
+
+// handleSignalBlocked mutes the portals of blocked chats, and unmutes the ones that were unblocked
+func (user *User) handleSignalBlocked(msg signalmeow.IncomingSignalMessageBlocked) {
+	blocked := make(map[string]bool, len(msg.BlockedUUIDs)+len(msg.BlockedGroupIDs))
+	for _, blockedUUID := range msg.BlockedUUIDs {
+		blocked[blockedUUID] = true
+	}
+	for _, groupID := range msg.BlockedGroupIDs {
+		blocked[string(groupID)] = true
+	}
+	user.blockedChatsLock.Lock()
+	previouslyBlocked := user.blockedChats
+	user.blockedChats = blocked
+	user.blockedChatsLock.Unlock()
+	user.log.Debug().Msgf("Blocked list synced with %d contacts and %d groups", len(msg.BlockedUUIDs), len(msg.BlockedGroupIDs))
+
+	doublePuppet := user.bridge.GetPuppetByCustomMXID(user.MXID)
+	if doublePuppet == nil || doublePuppet.CustomIntent() == nil {
+		return
+	}
+	for _, portal := range user.bridge.getAllPortalsWithMXID() {
+		if portal.IsPrivateChat() && portal.Receiver != user.SignalUsername {
+			continue
+		}
+		if blocked[portal.ChatID] != previouslyBlocked[portal.ChatID] {
+			user.updateChatMute(doublePuppet.CustomIntent(), portal, blocked[portal.ChatID])
+		}
+	}
+}
+
+func (user *User) isChatBlocked(chatID string) bool {
+	user.blockedChatsLock.Lock()
+	defer user.blockedChatsLock.Unlock()
+	return user.blockedChats[chatID]
+}
+
+// handleSignalMessageRequestResponse applies a message request that was accepted, deleted or blocked on another device
+func (user *User) handleSignalMessageRequestResponse(msg signalmeow.IncomingSignalMessageMessageRequestResponse) {
+	chatID := msg.RecipientUUID
+	if msg.GroupID != nil {
+		chatID = string(*msg.GroupID)
+	}
+	user.log.Debug().Msgf("Message request for %s answered with %s", chatID, msg.ResponseType)
+	if msg.ResponseType == signalpb.SyncMessage_MessageRequestResponse_BLOCK {
+		// The chat will also be in the next blocked list, but mute it right away
+		user.blockedChatsLock.Lock()
+		if user.blockedChats == nil {
+			user.blockedChats = make(map[string]bool)
+		}
+		user.blockedChats[chatID] = true
+		user.blockedChatsLock.Unlock()
+	}
+	// Only accepting a message request creates a portal, the other responses are for existing ones
+	if msg.ResponseType != signalpb.SyncMessage_MessageRequestResponse_ACCEPT &&
+		user.bridge.DB.Portal.GetByChatID(database.NewPortalKey(chatID, user.SignalUsername)) == nil {
+		user.log.Debug().Msgf("No portal found for message request response in %s", chatID)
+		return
+	}
+	portal := user.GetPortalByChatID(chatID)
+	switch msg.ResponseType {
+	case signalpb.SyncMessage_MessageRequestResponse_ACCEPT:
+		if portal.MXID != "" {
+			return
+		}
+		err := portal.CreateMatrixRoom(user, nil)
+		if err != nil {
+			user.log.Err(err).Msgf("Failed to create portal for accepted message request in %s", chatID)
+			return
+		}
+		_ = ensureGroupPuppetsAreJoinedToPortal(context.Background(), user, portal)
+	case signalpb.SyncMessage_MessageRequestResponse_BLOCK:
+		doublePuppet := user.bridge.GetPuppetByCustomMXID(user.MXID)
+		if doublePuppet != nil && doublePuppet.CustomIntent() != nil {
+			user.updateChatMute(doublePuppet.CustomIntent(), portal, true)
+		}
+	case signalpb.SyncMessage_MessageRequestResponse_DELETE, signalpb.SyncMessage_MessageRequestResponse_BLOCK_AND_DELETE:
+		portal.Cleanup(false)
+		portal.Delete()
+	}
+}
+
+// updateChatMute mutes or unmutes a portal for the user with a room push rule, using their double puppet
+func (user *User) updateChatMute(intent *appservice.IntentAPI, portal *Portal, muted bool) {
+	if portal.MXID == "" {
+		return
+	}
+	var err error
+	if muted {
+		user.log.Debug().Msgf("Muting %s", portal.MXID)
+		err = intent.PutPushRule("global", pushrules.RoomRule, string(portal.MXID), &mautrix.ReqPutPushRule{
+			Actions: []pushrules.PushActionType{pushrules.ActionDontNotify},
+		})
+	} else {
+		user.log.Debug().Msgf("Unmuting %s", portal.MXID)
+		err = intent.DeletePushRule("global", pushrules.RoomRule, string(portal.MXID))
+	}
+	if err != nil {
+		user.log.Warn().Err(err).Msgf("Failed to update mute status of %s", portal.MXID)
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"maunium.net/go/mautrix"
+	"maunium.net/go/mautrix/appservice"
+	"maunium.net/go/mautrix/event"
+
+	"go.mau.fi/mautrix-signal/pkg/signalmeow"
+	signalpb "go.mau.fi/mautrix-signal/pkg/signalmeow/protobuf"
+)
+
+const beeperLinkPreviewsKey = "com.beeper.linkpreviews"
+
+type BeeperLinkPreview struct {
+	mautrix.RespPreviewURL
+	MatchedURL      string                   `json:"matched_url"`
+	ImageEncryption *event.EncryptedFileInfo `json:"beeper:image:encryption,omitempty"`
+}
+
+var urlRegex = regexp.MustCompile(`https?://[^\s<>"]+[^\s<>".,;:!?)\]'}]`)
+
+func (portal *Portal) convertSignalPreviewsToBeeper(intent *appservice.IntentAPI, previews []signalmeow.IncomingSignalMessagePreview) []*BeeperLinkPreview {
+	output := make([]*BeeperLinkPreview, 0, len(previews))
+	for _, preview := range previews {
+		output = append(output, portal.convertSignalPreviewToBeeper(intent, preview))
+	}
+	return output
+}
+
+func (portal *Portal) convertSignalPreviewToBeeper(intent *appservice.IntentAPI, preview signalmeow.IncomingSignalMessagePreview) *BeeperLinkPreview {
+	output := &BeeperLinkPreview{
+		MatchedURL: preview.URL,
+		RespPreviewURL: mautrix.RespPreviewURL{
+			CanonicalURL: preview.URL,
+			Title:        preview.Title,
+			Description:  preview.Description,
+		},
+	}
+	if preview.Image == nil {
+		return output
+	}
+	var buf bytes.Buffer
+	err := signalmeow.DownloadAttachment(preview.Image, &buf, portal.bridge.Config.Bridge.MaxAttachmentSize())
+	if err != nil {
+		portal.log.Err(err).Str("url", preview.URL).Msg("Failed to download link preview image")
+		return output
+	}
+	data := buf.Bytes()
+	pointer := (*signalpb.AttachmentPointer)(preview.Image)
+	mimeType := pointer.GetContentType()
+	if mimeType == "" {
+		mimeType = http.DetectContentType(data)
+	}
+	output.ImageType = mimeType
+	output.ImageSize = len(data)
+	output.ImageWidth = int(pointer.GetWidth())
+	output.ImageHeight = int(pointer.GetHeight())
+	if output.ImageWidth == 0 || output.ImageHeight == 0 {
+		cfg, _, _ := image.DecodeConfig(bytes.NewReader(data))
+		output.ImageWidth, output.ImageHeight = cfg.Width, cfg.Height
+	}
+	uri, file, err := portal.uploadToMatrix(intent, data, mimeType)
+	if err != nil {
+		portal.log.Err(err).Str("url", preview.URL).Msg("Failed to upload link preview image")
+		return output
+	}
+	if file != nil {
+		output.ImageEncryption = file
+	} else {
+		output.ImageURL = uri
+	}
+	return output
+}
+
+// getBeeperLinkPreview returns the first link preview included in a Matrix event, if there is one.
+func getBeeperLinkPreview(evt *event.Event) (*BeeperLinkPreview, bool) {
+	raw := evt.Content.Raw
+	if newContent, ok := raw["m.new_content"].(map[string]interface{}); ok {
+		raw = newContent
+	}
+	rawPreviews, ok := raw[beeperLinkPreviewsKey]
+	if !ok {
+		return nil, false
+	}
+	var previews []*BeeperLinkPreview
+	if data, err := json.Marshal(rawPreviews); err != nil {
+		return nil, true
+	} else if err = json.Unmarshal(data, &previews); err != nil || len(previews) == 0 || previews[0] == nil {
+		// An explicitly empty list means the sender removed the preview
+		return nil, true
+	}
+	return previews[0], true
+}
+
+// convertURLPreviewToSignal attaches a link preview to an outgoing text message. The preview is taken from the
+// Matrix event if it has one, otherwise it's generated with the homeserver if url_previews is enabled.
+// Failing to make a preview is not fatal, the message is sent without it.
+func (portal *Portal) convertURLPreviewToSignal(ctx context.Context, sender *User, evt *event.Event, text string, msg *signalmeow.SignalContent) {
+	preview, hasBeeperPreview := getBeeperLinkPreview(evt)
+	if !hasBeeperPreview && portal.bridge.Config.Bridge.URLPreviews {
+		matchedURL := urlRegex.FindString(text)
+		if matchedURL == "" {
+			return
+		}
+		mxPreview, err := portal.MainIntent().GetURLPreview(matchedURL)
+		if err != nil {
+			portal.log.Err(err).Str("url", matchedURL).Msg("Failed to fetch URL preview")
+			return
+		}
+		preview = &BeeperLinkPreview{
+			RespPreviewURL: *mxPreview,
+			MatchedURL:     matchedURL,
+		}
+	}
+	if preview == nil {
+		return
+	}
+	previewURL := preview.MatchedURL
+	if previewURL == "" {
+		previewURL = preview.CanonicalURL
+	}
+	// Signal clients only show previews for links that are in the message text
+	if previewURL == "" || !strings.Contains(text, previewURL) {
+		return
+	} else if preview.Title == "" && preview.Description == "" && preview.ImageURL == "" && preview.ImageEncryption == nil {
+		return
+	}
+
+	var previewImage *signalmeow.AttachmentPointer
+	if preview.ImageURL != "" || preview.ImageEncryption != nil {
+		previewImage = portal.uploadURLPreviewImage(ctx, sender, preview)
+	}
+	signalmeow.AddPreviewToDataMessage(msg, previewURL, preview.Title, preview.Description, previewImage)
+}
+
+func (portal *Portal) uploadURLPreviewImage(ctx context.Context, sender *User, preview *BeeperLinkPreview) *signalmeow.AttachmentPointer {
+	imageContent := &event.MessageEventContent{
+		URL:  preview.ImageURL,
+		File: preview.ImageEncryption,
+		Info: &event.FileInfo{Size: preview.ImageSize},
+	}
+	if imageContent.File != nil && imageContent.File.URL == "" {
+		imageContent.File.URL = preview.ImageURL
+	}
+	data, err := portal.downloadAndDecryptMatrixMedia(ctx, imageContent)
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to download URL preview image")
+		return nil
+	}
+	mimeType := preview.ImageType
+	if mimeType == "" {
+		mimeType = http.DetectContentType(data)
+	}
+	previewImage, err := signalmeow.UploadAttachment(sender.SignalDevice, data, mimeType, "")
+	if err != nil {
+		portal.log.Err(err).Msg("Failed to upload URL preview image to Signal")
+		return nil
+	}
+	return previewImage
+}
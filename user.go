@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -43,6 +44,14 @@ type User struct {
 
 	BridgeState     *bridge.BridgeStateQueue
 	bridgeStateLock sync.Mutex
+
+	// Settings synced from the primary device
+	blockedChats             map[string]bool
+	blockedChatsLock         sync.Mutex
+	readReceiptsDisabled     atomic.Bool
+	typingIndicatorsDisabled atomic.Bool
+
+	startupContactSyncPending atomic.Bool
 }
 
 var _ bridge.User = (*User)(nil)
@@ -219,6 +228,9 @@ func (user *User) syncChatDoublePuppetDetails(portal *Portal, justCreated bool)
 	if doublePuppet == nil || doublePuppet.CustomIntent() == nil || len(portal.MXID) == 0 {
 		return
 	}
+	if justCreated && user.isChatBlocked(portal.ChatID) {
+		user.updateChatMute(doublePuppet.CustomIntent(), portal, true)
+	}
 
 	// TODO: Get chat setting from Signal and sync them here
 	//if justCreated || !user.bridge.Config.Bridge.TagOnlyOnCreate {
@@ -305,6 +317,7 @@ func (user *User) startupTryConnect(retryCount int) {
 	}
 	// After Connect returns, all bridge states are triggered by events on the statusChan
 	go func() {
+		portalsSynced := false
 		for {
 			connectionStatus, ok := <-statusChan
 			if !ok {
@@ -316,6 +329,12 @@ func (user *User) startupTryConnect(retryCount int) {
 			case signalmeow.SignalConnectionEventConnected:
 				user.log.Debug().Msg("Sending Connected BridgeState")
 				user.BridgeState.Send(status.BridgeState{StateEvent: status.StateConnected})
+				if !portalsSynced {
+					portalsSynced = true
+					// Private chats are created when the contact list arrives, since that's where the chat list is
+					user.startupContactSyncPending.Store(true)
+					go user.createGroupPortals()
+				}
 
 			case signalmeow.SignalConnectionEventDisconnected:
 				user.log.Debug().Msg("Sending TransientDisconnect BridgeState")
@@ -377,6 +396,83 @@ func (br *SignalBridge) StartUsers() {
 	}
 }
 
+// createGroupPortals creates portals for the groups we know of that don't have one yet
+func (user *User) createGroupPortals() {
+	ctx := context.Background()
+	groupIDs, err := signalmeow.KnownGroupIdentifiers(ctx, user.SignalDevice)
+	if err != nil {
+		user.log.Err(err).Msg("Failed to get known groups")
+		return
+	}
+	user.log.Info().Msgf("Creating portals for %d known groups", len(groupIDs))
+	for _, groupID := range groupIDs {
+		portal := user.GetPortalByChatID(string(groupID))
+		if portal == nil || portal.MXID != "" {
+			continue
+		}
+		group, avatarImage, err := signalmeow.RetrieveGroupAndAvatarByID(ctx, user.SignalDevice, groupID)
+		if err != nil {
+			// Most likely we're not in the group anymore
+			user.log.Debug().Err(err).Msgf("Not creating portal for group %s", groupID)
+			continue
+		}
+		_, err = portal.updateGroupMetadata(group, avatarImage)
+		if err != nil {
+			user.log.Err(err).Msgf("Failed to set metadata of group %s", groupID)
+		}
+		err = portal.CreateMatrixRoom(user, nil)
+		if err != nil {
+			user.log.Err(err).Msgf("Failed to create portal for group %s", groupID)
+			continue
+		}
+		_ = ensureGroupPuppetsAreJoinedToPortal(ctx, user, portal)
+	}
+}
+
+// createStorageGroupPortals finds the groups in the storage service and creates portals for them.
+// Right after logging in, that's the only place where the groups are listed.
+func (user *User) createStorageGroupPortals(storageKey []byte) {
+	groupIDs, err := signalmeow.FetchGroupsFromStorage(context.Background(), user.SignalDevice, storageKey)
+	if err != nil {
+		// Groups that were found before the error are still stored
+		user.log.Err(err).Msg("Failed to get groups from the storage service")
+	}
+	if len(groupIDs) > 0 {
+		user.log.Debug().Msgf("Found %d groups in the storage service", len(groupIDs))
+		user.createGroupPortals()
+	}
+}
+
+// createPrivateChatPortals creates portals for the most recent private chats in the chat list,
+// up to the startup_private_channel_create_limit. The contacts must be sorted by inbox position.
+func (user *User) createPrivateChatPortals(contacts []signalmeow.SyncedContact) {
+	limit := user.bridge.Config.Bridge.PrivateChannelCreateLimit
+	created := 0
+	for _, contact := range contacts {
+		if limit >= 0 && created >= limit {
+			break
+		}
+		if contact.InboxPosition == 0 || contact.Blocked || contact.UUID == user.SignalID {
+			continue
+		}
+		created++
+		portal := user.GetPortalByChatID(contact.UUID)
+		if portal == nil || portal.MXID != "" {
+			continue
+		}
+		if portal.shouldSetDMRoomMetadata() {
+			if puppet := user.bridge.GetPuppetBySignalID(contact.UUID); puppet != nil {
+				portal.Name = puppet.Name
+			}
+		}
+		err := portal.CreateMatrixRoom(user, nil)
+		if err != nil {
+			user.log.Err(err).Msgf("Failed to create portal for private chat with %s", contact.UUID)
+		}
+	}
+	user.log.Info().Msgf("Created or found portals for %d recent private chats", created)
+}
+
 func (user *User) Login() (<-chan signalmeow.ProvisioningResponse, error) {
 	user.Lock()
 	defer user.Unlock()
@@ -423,8 +519,11 @@ func updatePuppetWithSignalProfile(ctx context.Context, user *User, puppet *Pupp
 		user.log.Err(err).Msg("error retrieving profile")
 		return err
 	}
-	if profile.Name != puppet.Name {
+	if profile.Name == "" && puppet.NameQuality == puppetQualityContact {
+		// Keep the name from the contact list if the profile doesn't have one
+	} else if profile.Name != puppet.Name {
 		puppet.Name = profile.Name
+		puppet.NameQuality = puppetQualityProfile
 		err = puppet.DefaultIntent().SetDisplayName(profile.Name)
 		if err != nil {
 			user.log.Err(err).Msg("error setting display name")
@@ -440,9 +539,14 @@ func updatePuppetWithSignalProfile(ctx context.Context, user *User, puppet *Pupp
 	}
 
 	if profile.AvatarPath == "" {
+		if puppet.AvatarQuality == puppetQualityContact {
+			// Keep the avatar from the contact list if the profile doesn't have one
+			return nil
+		}
 		puppet.AvatarSet = false
 		puppet.AvatarURL = id.ContentURI{}
 		puppet.AvatarHash = ""
+		puppet.AvatarQuality = puppetQualityNone
 		err = puppet.Update()
 		if err != nil {
 			user.log.Err(err).Msg("error updating puppet")
@@ -461,6 +565,7 @@ func updatePuppetWithSignalProfile(ctx context.Context, user *User, puppet *Pupp
 				return err
 			}
 			puppet.AvatarURL = avatarURL.ContentURI
+			puppet.AvatarQuality = puppetQualityProfile
 			puppet.AvatarSet = true
 			hash := sha256.Sum256(avatarImage)
 			puppet.AvatarHash = hex.EncodeToString(hash[:])
@@ -491,36 +596,25 @@ func ensureGroupPuppetsAreJoinedToPortal(ctx context.Context, user *User, portal
 
 	// Check if ChatID is a groupID (not a UUID), otherwise do nothing else
 	// TODO: do better than passing around strings and seeing if they are UUIDs or not
-	if _, err := uuid.Parse(portal.ChatID); err == nil {
+	if _, err := uuid.Parse(portal.ChatID); err == nil || portal.IsStories() {
 		return nil
 	}
-	user.log.Info().Msgf("Ensuring everyone is joined to room %s, groupID: %s", portal.MXID, portal.ChatID)
+	user.log.Debug().Msgf("Ensuring everyone is joined to room %s, groupID: %s", portal.MXID, portal.ChatID)
 	group, err := signalmeow.RetrieveGroupByID(ctx, user.SignalDevice, signalmeow.GroupIdentifier(portal.ChatID))
 	if err != nil {
 		user.log.Err(err).Msg("error retrieving group")
 		return err
 	}
-	for _, member := range group.Members {
-		if member.UserId == user.SignalID {
-			continue
-		}
-		memberPuppet := portal.bridge.GetPuppetBySignalID(member.UserId)
-		if memberPuppet == nil {
-			user.log.Err(err).Msgf("no puppet found for signalID %s", member.UserId)
-			continue
-		}
-		_ = updatePuppetWithSignalProfile(context.Background(), user, memberPuppet)
-		err = memberPuppet.DefaultIntent().EnsureJoined(portal.MXID)
-		if err != nil {
-			user.log.Err(err).Msg("error ensuring joined")
-		}
-	}
+	portal.syncGroupMembership(user, group)
 	return nil
 }
 
 func (user *User) incomingMessageHandler(incomingMessage signalmeow.IncomingSignalMessage) error {
 	// Handle things common to all message types
 	m := incomingMessage.Base()
+	if m.SenderUUID == user.SignalID && user.handleSignalAccountMessage(incomingMessage) {
+		return nil
+	}
 	var chatID string
 	var senderPuppet *Puppet
 
@@ -553,6 +647,11 @@ func (user *User) incomingMessageHandler(incomingMessage signalmeow.IncomingSign
 		}
 	}
 
+	// Stories from everyone (including group stories) go to the stories room
+	if incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeStory {
+		chatID = storiesChatID
+	}
+
 	// If this is a receipt, the chatID/portal is the room where the message was read
 	if incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeReceipt {
 		receiptMessage := incomingMessage.(signalmeow.IncomingSignalMessageReceipt)
@@ -566,6 +665,17 @@ func (user *User) incomingMessageHandler(incomingMessage signalmeow.IncomingSign
 		chatID = dbMessage.SignalChatID
 	}
 
+	// View-once media opened on another device is found the same way
+	if incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeViewOnceOpen {
+		viewOnceOpen := incomingMessage.(signalmeow.IncomingSignalMessageViewOnceOpen)
+		dbMessage := user.bridge.DB.Message.FindBySenderAndTimestamp(viewOnceOpen.TargetAuthorUUID, viewOnceOpen.TargetMessageTimestamp)
+		if dbMessage == nil {
+			user.log.Warn().Msgf("View-once open received for unknown message %s %d", viewOnceOpen.TargetAuthorUUID, viewOnceOpen.TargetMessageTimestamp)
+			return nil
+		}
+		chatID = dbMessage.SignalChatID
+	}
+
 	// Get and update the portal for this message
 	portal := user.GetPortalByChatID(chatID)
 	if portal == nil {
@@ -576,7 +686,13 @@ func (user *User) incomingMessageHandler(incomingMessage signalmeow.IncomingSign
 
 	// Don't bother with portal updates for receipts or typing notifications
 	// (esp. read receipts - they don't have GroupID set so it breaks)
-	if !(incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeReceipt || incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeTyping) {
+	// Group changes are applied by the portal itself, so they can be attributed to whoever made them
+	// The stories room has fixed metadata
+	if !portal.IsStories() && !(incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeReceipt ||
+		incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeTyping ||
+		incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeGroupChange ||
+		incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeViewOnceOpen ||
+		incomingMessage.MessageType() == signalmeow.IncomingSignalMessageTypeCallEvent) {
 		updatePortal := false
 		if m.GroupID != nil {
 			group, avatarImage, err := signalmeow.RetrieveGroupAndAvatarByID(context.Background(), user.SignalDevice, *m.GroupID)
@@ -584,24 +700,10 @@ func (user *User) incomingMessageHandler(incomingMessage signalmeow.IncomingSign
 				user.log.Err(err).Msg("error retrieving group")
 				return err
 			}
-			if portal.Name != group.Title || portal.Topic != group.Description {
-				portal.Name = group.Title
-				portal.Topic = group.Description
-				updatePortal = true
-			}
-			// avatarImage is only not nil if there's a new avatar to set
-			if avatarImage != nil {
-				user.log.Debug().Msg("Uploading new group avatar")
-				avatarURL, err := portal.MainIntent().UploadBytes(avatarImage, http.DetectContentType(avatarImage))
-				if err != nil {
-					user.log.Err(err).Msg("error uploading group avatar")
-					return err
-				}
-				portal.AvatarURL = avatarURL.ContentURI
-				portal.AvatarSet = true
-				hash := sha256.Sum256(avatarImage)
-				portal.AvatarHash = hex.EncodeToString(hash[:])
-				updatePortal = true
+			updatePortal, err = portal.updateGroupMetadata(group, avatarImage)
+			if err != nil {
+				user.log.Err(err).Msg("error updating group metadata")
+				return err
 			}
 
 			// ensure everyone is invited to the group
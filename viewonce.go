@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"maunium.net/go/mautrix/appservice"
+	"maunium.net/go/mautrix/event"
+	"maunium.net/go/mautrix/id"
+
+	"go.mau.fi/mautrix-signal/pkg/signalmeow"
+)
+
+// How long view-once media stays in the room after it's read on Matrix, so that there's time to look at it
+const viewOnceViewTime = 1 * time.Minute
+
+// handleSignalViewOnceNotice sends a notice in place of view-once media, for the notice view-once mode
+func (portal *Portal) handleSignalViewOnceNotice(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	base := portalMessage.message.Base()
+	content := &event.MessageEventContent{
+		MsgType: event.MsgNotice,
+		Body:    "Received view-once media. Open Signal on your phone to view it.",
+	}
+	if base.SenderUUID == portalMessage.user.SignalID {
+		content.Body = "You sent view-once media from another device."
+	}
+	portal.addSignalQuote(content, base.Quote)
+	resp, err := portal.sendMatrixMessage(intent, event.EventMessage, content, nil, 0)
+	if err != nil {
+		return err
+	}
+	if resp.EventID == "" {
+		return errors.New("Didn't receive event ID from Matrix")
+	}
+	portal.storeMessageInDB(resp.EventID, portalMessage.sender.SignalID, base.Timestamp, base.PartIndex)
+	portal.markSignalMessageDisappearing(portalMessage, resp.EventID)
+	return nil
+}
+
+// markSignalMessageViewOnce remembers bridged view-once media so it can be redacted once it's read,
+// with a disappearing timer as a fallback in case it's never read.
+func (portal *Portal) markSignalMessageViewOnce(portalMessage portalSignalMessage, eventID id.EventID) {
+	base := portalMessage.message.Base()
+	portal.bridge.DB.ViewOnceMessage.NewWithValues(portal.MXID, eventID, base.SenderUUID, base.Timestamp).Insert(nil)
+	portal.MarkDisappearing(eventID, portal.bridge.Config.Bridge.ViewOnceTimeout(), true)
+}
+
+// openViewOnceMessages handles the user reading view-once media on Matrix: the media is redacted
+// and Signal is told it was opened, so it's removed from the user's other devices too.
+func (portal *Portal) openViewOnceMessages(user *User, upToTimestamp uint64) {
+	if portal.MXID == "" {
+		return
+	}
+	for _, viewOnce := range portal.bridge.DB.ViewOnceMessage.GetUpTo(portal.MXID, upToTimestamp) {
+		viewOnce.Delete()
+		if viewOnce.Sender != user.SignalID {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			err := signalmeow.SendViewOnceOpen(ctx, user.SignalDevice, viewOnce.Sender, viewOnce.Timestamp)
+			cancel()
+			if err != nil {
+				portal.log.Warn().Err(err).Msgf("Failed to send view-once open for %s", viewOnce.EventID)
+			}
+		}
+		go portal.redactViewOnceMessage(viewOnce.EventID)
+	}
+}
+
+func (portal *Portal) redactViewOnceMessage(eventID id.EventID) {
+	time.Sleep(viewOnceViewTime)
+	portal.removeViewOnceMessage(eventID)
+}
+
+func (portal *Portal) removeViewOnceMessage(eventID id.EventID) {
+	_, err := portal.MainIntent().RedactEvent(portal.MXID, eventID)
+	if err != nil {
+		portal.log.Warn().Err(err).Msgf("Failed to redact viewed view-once message %s", eventID)
+		return
+	}
+	portal.log.Debug().Msgf("Redacted viewed view-once message %s", eventID)
+	// The fallback timer isn't needed anymore
+	if msg := portal.bridge.DB.DisappearingMessage.GetByMXID(portal.MXID, eventID); msg != nil {
+		msg.Delete()
+	}
+}
+
+// handleSignalViewOnceOpenMessage removes view-once media that was opened on another device.
+// There's no need to wait before redacting, since it's already been viewed.
+func (portal *Portal) handleSignalViewOnceOpenMessage(portalMessage portalSignalMessage, intent *appservice.IntentAPI) error {
+	if portal.bridge.Config.Bridge.ViewOnce.Mode != "redact" {
+		// The media is either kept in the room or was never bridged, so there's nothing to remove
+		return nil
+	}
+	msg := (portalMessage.message).(signalmeow.IncomingSignalMessageViewOnceOpen)
+	viewOnceMessages := portal.bridge.DB.ViewOnceMessage.GetBySignalID(portal.MXID, msg.TargetAuthorUUID, msg.TargetMessageTimestamp)
+	if len(viewOnceMessages) == 0 {
+		portal.log.Debug().Msgf("No unviewed view-once media found for %s/%d", msg.TargetAuthorUUID, msg.TargetMessageTimestamp)
+		return nil
+	}
+	for _, viewOnce := range viewOnceMessages {
+		viewOnce.Delete()
+		portal.removeViewOnceMessage(viewOnce.EventID)
+	}
+	return nil
+}